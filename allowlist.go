@@ -1,11 +1,20 @@
 package hotcoal
 
-import "fmt"
+import (
+	"sort"
+	"strings"
+)
 
 // allowlistT holds an allowlist of items, which is used to validate string variables
-// such as column names or table names, guarding against SQL injection
+// such as column names or table names, guarding against SQL injection.
+// It's never modified after creating it, methods like With return a new allowlistT,
+// so it's safe to use from multiple goroutines. See MutableAllowlist if you need to modify it.
 type allowlistT struct {
-	items map[hotcoalString]unitT
+	// items maps the allowed values to the hotcoalStrings returned by Validate,
+	// they are the same, unless the allowlist was created using AllowlistMap
+	items   map[string]hotcoalString
+	fold    bool
+	verbose bool
 }
 
 type unitT = struct{}
@@ -16,35 +25,296 @@ var unit = unitT{}
 // such as column names or table names, guarding against SQL injection
 func Allowlist(firstAllowlistItem hotcoalString, otherAllowlistItems ...hotcoalString) allowlistT {
 	ret := allowlistT{
-		items: map[hotcoalString]unitT{
-			firstAllowlistItem: unit,
+		items: map[string]hotcoalString{
+			string(firstAllowlistItem): firstAllowlistItem,
 		},
 	}
 
 	for _, el := range otherAllowlistItems {
-		ret.items[el] = unit
+		ret.items[string(el)] = el
+	}
+
+	return ret
+}
+
+// NewAllowlist creates an allowlistT from any number of items.
+// Unlike Allowlist, it accepts zero items, producing an allowlist which rejects every value,
+// so you can use it to build allowlists dynamically.
+func NewAllowlist(items ...hotcoalString) allowlistT {
+	return AllowlistFromSlice(items)
+}
+
+// AllowlistFromSlice creates an allowlistT from the items of a Slice.
+// If the Slice is empty, the allowlist rejects every value.
+func AllowlistFromSlice(items Slice) allowlistT {
+	ret := allowlistT{
+		items: make(map[string]hotcoalString, len(items)),
+	}
+
+	for _, el := range items {
+		ret.items[string(el)] = el
 	}
 
 	return ret
 }
 
+// AllowlistMap creates an allowlistT, which maps the allowed values to hotcoalStrings.
+// Validate returns the hotcoalString the value is mapped to, e.g. you can validate
+// the names of your API and get the names of your database columns:
+//
+//	allowlist := hotcoal.AllowlistMap(hotcoal.Map{
+//		"name":    "full_name",
+//		"created": "created_at",
+//	})
+//
+// The map is copied. If the map is empty, the allowlist rejects every value.
+func AllowlistMap(m Map) allowlistT {
+	ret := allowlistT{
+		items: make(map[string]hotcoalString, len(m)),
+	}
+
+	for value, item := range m {
+		ret.items[value] = item
+	}
+
+	return ret
+}
+
+// Union creates an allowlistT containing the items of all the allowlists.
+// It matches values case-insensitively only if all the allowlists do,
+// and it is verbose only if all the allowlists are.
+// If several allowlists map the same value, the last one wins, see AllowlistMap.
+// The allowlists are not modified.
+func Union(allowlists ...allowlistT) allowlistT {
+	ret := allowlistT{
+		items:   map[string]hotcoalString{},
+		fold:    len(allowlists) > 0,
+		verbose: len(allowlists) > 0,
+	}
+
+	for _, allowlist := range allowlists {
+		for value, item := range allowlist.items {
+			ret.items[value] = item
+		}
+
+		ret.fold = ret.fold && allowlist.fold
+		ret.verbose = ret.verbose && allowlist.verbose
+	}
+
+	return ret
+}
+
+// AllowlistFold creates an allowlistT, which matches values case-insensitively,
+// using Unicode case folding (see strings.EqualFold https://pkg.go.dev/strings#EqualFold).
+// Validate returns the allowlist item as it was given to AllowlistFold,
+// not the value being validated, so the casing in your SQL stays consistent.
+func AllowlistFold(firstAllowlistItem hotcoalString, otherAllowlistItems ...hotcoalString) allowlistT {
+	ret := Allowlist(firstAllowlistItem, otherAllowlistItems...)
+	ret.fold = true
+
+	return ret
+}
+
+// AllowlistVerbose creates an allowlistT, whose validation errors list all the allowlist items.
+// It's useful for debugging, but please don't use it in production, since the error messages
+// may leak your database schema into logs or API responses.
+func AllowlistVerbose(firstAllowlistItem hotcoalString, otherAllowlistItems ...hotcoalString) allowlistT {
+	ret := Allowlist(firstAllowlistItem, otherAllowlistItems...)
+	ret.verbose = true
+
+	return ret
+}
+
+// lookup returns the allowlist item matching the value.
+// If several values match case-insensitively, the item of the smallest one is returned,
+// so the result doesn't depend on map iteration order.
+func (a allowlistT) lookup(value string) (hotcoalString, bool) {
+	if item, ok := a.items[value]; ok {
+		return item, true
+	}
+
+	if !a.fold {
+		return "", false
+	}
+
+	var match string
+	found := false
+
+	for el := range a.items {
+		if strings.EqualFold(el, value) && (!found || el < match) {
+			match = el
+			found = true
+		}
+	}
+
+	return a.items[match], found
+}
+
 // The Validate method validates a string variable against the allowlist and returns a hotcoalString.
-// If the value is not in the allowlist, it returns an error.
+// The returned hotcoalString is the entry stored in the allowlist, never the value itself,
+// so it comes from a trusted source even when the match is case-insensitive or an alias.
+// If the value is not in the allowlist, it returns a *ValidationError.
+//
+//hotcoal:boundary
 func (a allowlistT) Validate(value string) (hotcoalString, error) {
-	if _, ok := a.items[hotcoalString(value)]; ok {
-		return hotcoalString(value), nil
+	if item, ok := a.lookup(value); ok {
+		return item, nil
+	}
+
+	err := &ValidationError{Value: value}
+	if a.verbose {
+		err.items = a.Items()
 	}
 
-	return "", fmt.Errorf("Hotcoal validation error - value %#v is not in allowlist %#v", value, a.items)
+	return "", err
+}
+
+// The ValidateHotcoal method validates a hotcoalString against the allowlist, e.g. the result
+// of another validation, and returns the hotcoalString stored in the allowlist.
+// If the value is not in the allowlist, it returns a *ValidationError.
+func (a allowlistT) ValidateHotcoal(value hotcoalString) (hotcoalString, error) {
+	return a.Validate(string(value))
+}
+
+// The ValidateOrDefault method validates a string variable against the allowlist and returns a hotcoalString.
+// If the value is not in the allowlist, e.g. an optional sort column is missing, it returns def.
+//
+//hotcoal:boundary
+func (a allowlistT) ValidateOrDefault(value string, def hotcoalString) hotcoalString {
+	if item, ok := a.lookup(value); ok {
+		return item
+	}
+
+	return def
+}
+
+// The ValidateAs method validates a column name against the allowlist and returns it with an alias,
+// e.g. "first_name AS name", for the column list of a SELECT statement.
+// If the column is not in the allowlist, it returns a *ValidationError.
+//
+//hotcoal:boundary
+func (a allowlistT) ValidateAs(column string, alias hotcoalString) (hotcoalString, error) {
+	item, err := a.Validate(column)
+	if err != nil {
+		return "", err
+	}
+
+	return item + " AS " + alias, nil
 }
 
 // The V method is an shorthand for Validate
+//
+//hotcoal:boundary
 func (a allowlistT) V(value string) (hotcoalString, error) {
 	return a.Validate(value)
 }
 
+// The Contains method reports whether the value is in the allowlist.
+func (a allowlistT) Contains(value string) bool {
+	_, ok := a.lookup(value)
+
+	return ok
+}
+
+// The Size method returns the number of allowed values.
+// For an allowlist created using AllowlistMap, it's the number of keys of the map.
+func (a allowlistT) Size() int {
+	return len(a.items)
+}
+
+// The IsEmpty method reports whether the allowlist has no items, so it rejects every value.
+func (a allowlistT) IsEmpty() bool {
+	return len(a.items) == 0
+}
+
+// The Items method returns a sorted copy of the allowed values as plain strings.
+// For an allowlist created using AllowlistMap, these are the keys of the map.
+func (a allowlistT) Items() []string {
+	ret := make([]string, 0, len(a.items))
+	for el := range a.items {
+		ret = append(ret, el)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+// The HotcoalItems method returns a sorted copy of the allowlist items as hotcoalStrings,
+// without duplicates. For an allowlist created using AllowlistMap, these are the values of the map.
+func (a allowlistT) HotcoalItems() Slice {
+	ret := make(Slice, 0, len(a.items))
+	for _, item := range a.items {
+		ret = append(ret, item)
+	}
+
+	return ret.Sort().Dedup()
+}
+
+// The With method returns a new allowlistT with the items added.
+// The original allowlist is not modified, so it's safe to share it.
+func (a allowlistT) With(items ...hotcoalString) allowlistT {
+	ret := a.clone()
+
+	for _, el := range items {
+		ret.items[string(el)] = el
+	}
+
+	return ret
+}
+
+// The Without method returns a new allowlistT with the items removed.
+// For an allowlist created using AllowlistMap, it also removes the values mapped to the items.
+// The original allowlist is not modified, so it's safe to share it.
+func (a allowlistT) Without(items ...hotcoalString) allowlistT {
+	ret := a.clone()
+
+	for _, el := range items {
+		delete(ret.items, string(el))
+
+		for value, item := range ret.items {
+			if item == el {
+				delete(ret.items, value)
+			}
+		}
+	}
+
+	return ret
+}
+
+// The Filter method returns a new allowlistT with only the items for which keep returns true,
+// e.g. allowlist.Filter(func(item hotcoalString) bool { return item.HasSuffix("_id") }).
+// For an allowlist created using AllowlistMap, keep receives the values of the map.
+// The original allowlist is not modified, so it's safe to share it.
+func (a allowlistT) Filter(keep func(hotcoalString) bool) allowlistT {
+	ret := a
+	ret.items = make(map[string]hotcoalString, len(a.items))
+
+	for value, item := range a.items {
+		if keep(item) {
+			ret.items[value] = item
+		}
+	}
+
+	return ret
+}
+
+// clone returns a copy of the allowlist, which doesn't share the items map
+func (a allowlistT) clone() allowlistT {
+	ret := a
+	ret.items = make(map[string]hotcoalString, len(a.items))
+
+	for value, item := range a.items {
+		ret.items[value] = item
+	}
+
+	return ret
+}
+
 // The MustValidate method validates a string variable against the allowlist and returns a hotcoalString.
 // If the value is not in the allowlist, it panics.
+//
+//hotcoal:boundary
 func (a allowlistT) MustValidate(value string) hotcoalString {
 	ret, err := a.Validate(value)
 	if err != nil {
@@ -55,6 +325,78 @@ func (a allowlistT) MustValidate(value string) hotcoalString {
 }
 
 // The MV method is an shorthand for MustValidate
+//
+//hotcoal:boundary
 func (a allowlistT) MV(value string) hotcoalString {
 	return a.MustValidate(value)
 }
+
+// The ValidateAll method validates string variables against the allowlist and returns a Slice.
+// If any value is not in the allowlist, it returns the error of the first one.
+//
+//hotcoal:boundary
+func (a allowlistT) ValidateAll(values []string) (Slice, error) {
+	ret := make(Slice, 0, len(values))
+
+	for _, value := range values {
+		item, err := a.Validate(value)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, item)
+	}
+
+	return ret, nil
+}
+
+// The ValidateAllCollecting method validates string variables against the allowlist.
+// Unlike ValidateAll, it doesn't stop at the first invalid value: it returns a Slice of the valid ones,
+// and an error for each invalid one, in the order of values, so you can report all of them at once.
+// If all the values are valid, the errors are nil.
+//
+//hotcoal:boundary
+func (a allowlistT) ValidateAllCollecting(values []string) (Slice, []error) {
+	ret := make(Slice, 0, len(values))
+	var errs []error
+
+	for _, value := range values {
+		item, err := a.Validate(value)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		ret = append(ret, item)
+	}
+
+	return ret, errs
+}
+
+// The VA method is an shorthand for ValidateAll
+//
+//hotcoal:boundary
+func (a allowlistT) VA(values []string) (Slice, error) {
+	return a.ValidateAll(values)
+}
+
+// The MustValidateAll method validates string variables against the allowlist and returns a Slice.
+// If any value is not in the allowlist, it panics.
+// It's useful for validating configuration at startup.
+//
+//hotcoal:boundary
+func (a allowlistT) MustValidateAll(values []string) Slice {
+	ret, err := a.ValidateAll(values)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// The MVA method is an shorthand for MustValidateAll
+//
+//hotcoal:boundary
+func (a allowlistT) MVA(values []string) Slice {
+	return a.MustValidateAll(values)
+}
@@ -0,0 +1,40 @@
+package hotcoal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The MarshalJSON method implements json.Marshaler https://pkg.go.dev/encoding/json#Marshaler
+// It returns the allowed values as a sorted JSON array.
+// For an allowlist created using AllowlistMap, these are the keys of the map,
+// and the case-insensitivity and verbosity of the allowlist are not included.
+func (a allowlistT) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Items())
+}
+
+// AllowlistFromJSON creates an allowlistT from a JSON array of strings, e.g. ["first_name", "last_name"],
+// so you can load allowlists from a config file.
+//
+// Please note that it converts the strings to hotcoalStrings, so they are trusted:
+// only load allowlists from config managed by your operators, never from user input.
+// If the array is empty or null, the allowlist rejects every value.
+//
+//hotcoal:boundary
+func AllowlistFromJSON(data []byte) (allowlistT, error) {
+	var items []string
+
+	if err := json.Unmarshal(data, &items); err != nil {
+		return allowlistT{}, fmt.Errorf("Hotcoal error - cannot unmarshal allowlist: %w", err)
+	}
+
+	ret := allowlistT{
+		items: make(map[string]hotcoalString, len(items)),
+	}
+
+	for _, el := range items {
+		ret.items[el] = hotcoalString(el)
+	}
+
+	return ret, nil
+}
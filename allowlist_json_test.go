@@ -0,0 +1,79 @@
+package hotcoal
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAllowlistMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Allowlist("last_name", "first_name", "id"))
+	if `["first_name","id","last_name"]` != string(data) || err != nil {
+		t.Fail()
+	}
+
+	data, err = json.Marshal(NewAllowlist())
+	if `[]` != string(data) || err != nil {
+		t.Fail()
+	}
+
+	data, err = json.Marshal(map[string]interface{}{"columns": Allowlist("id")})
+	if `{"columns":["id"]}` != string(data) || err != nil {
+		t.Fail()
+	}
+}
+
+func TestAllowlistFromJSON(t *testing.T) {
+	allowlist, err := AllowlistFromJSON([]byte(`["last_name", "first_name", "id", "id"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual([]string{"first_name", "id", "last_name"}, allowlist.Items()) {
+		t.Fail()
+	}
+
+	hs, err := allowlist.Validate("first_name")
+	if "first_name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	if _, err := allowlist.Validate("password"); !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	for _, el := range []string{`[]`, `null`} {
+		allowlist, err := AllowlistFromJSON([]byte(el))
+		if !allowlist.IsEmpty() || err != nil {
+			t.Fail()
+		}
+	}
+}
+
+func TestAllowlistFromJSONError(t *testing.T) {
+	for _, el := range []string{``, `{}`, `"id"`, `[1, 2]`, `["id",`} {
+		allowlist, err := AllowlistFromJSON([]byte(el))
+		if !allowlist.IsEmpty() || err == nil {
+			t.Error(el)
+		}
+	}
+}
+
+func TestAllowlistJSONRoundTrip(t *testing.T) {
+	original := Allowlist("id", "first_name", "weird \"name\" ✓")
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allowlist, err := AllowlistFromJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(original.Items(), allowlist.Items()) || !reflect.DeepEqual(original.HotcoalItems(), allowlist.HotcoalItems()) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,75 @@
+package hotcoal
+
+import "sync"
+
+// MutableAllowlist is an allowlist which you can modify after creating it,
+// e.g. for an allowlist which is reloaded while the application is running.
+// Create it using NewMutableAllowlist.
+//
+// Unlike allowlistT, which is never modified after creating it and therefore safe to use
+// from multiple goroutines without locking, MutableAllowlist guards its items with a sync.RWMutex,
+// so it's safe to call Add and Remove concurrently with Validate.
+// Do not copy a MutableAllowlist.
+type MutableAllowlist struct {
+	mutex     sync.RWMutex
+	allowlist allowlistT
+}
+
+// NewMutableAllowlist creates a MutableAllowlist from any number of items.
+// If there are no items, the allowlist rejects every value until you Add some.
+func NewMutableAllowlist(items ...hotcoalString) *MutableAllowlist {
+	return &MutableAllowlist{
+		allowlist: NewAllowlist(items...),
+	}
+}
+
+// The Add method adds the items to the allowlist.
+func (m *MutableAllowlist) Add(items ...hotcoalString) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.allowlist = m.allowlist.With(items...)
+}
+
+// The Remove method removes the items from the allowlist.
+func (m *MutableAllowlist) Remove(items ...hotcoalString) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.allowlist = m.allowlist.Without(items...)
+}
+
+// The Snapshot method returns the current items as an allowlistT,
+// which is not affected by later calls of Add and Remove.
+func (m *MutableAllowlist) Snapshot() allowlistT {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	// Add and Remove replace the allowlistT instead of modifying it, so it can be shared
+	return m.allowlist
+}
+
+// The Validate method validates a string variable against the allowlist and returns a hotcoalString.
+// If the value is not in the allowlist, it returns a *ValidationError.
+//
+//hotcoal:boundary
+func (m *MutableAllowlist) Validate(value string) (hotcoalString, error) {
+	return m.Snapshot().Validate(value)
+}
+
+// The V method is an shorthand for Validate
+//
+//hotcoal:boundary
+func (m *MutableAllowlist) V(value string) (hotcoalString, error) {
+	return m.Validate(value)
+}
+
+// The Contains method reports whether the value is in the allowlist.
+func (m *MutableAllowlist) Contains(value string) bool {
+	return m.Snapshot().Contains(value)
+}
+
+// The Items method returns a sorted copy of the allowed values as plain strings.
+func (m *MutableAllowlist) Items() []string {
+	return m.Snapshot().Items()
+}
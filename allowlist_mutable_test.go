@@ -0,0 +1,99 @@
+package hotcoal
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestAllowlistConcurrentValidate(t *testing.T) {
+	allowlist := AllowlistFold("first_name", "last_name", "created_at")
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 1000; j++ {
+				if hs, err := allowlist.Validate("LAST_NAME"); "last_name" != hs.String() || err != nil {
+					t.Error(hs, err)
+					return
+				}
+
+				if _, err := allowlist.Validate("password"); !errors.Is(err, ErrNotInAllowlist) {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestMutableAllowlist(t *testing.T) {
+	allowlist := NewMutableAllowlist()
+
+	if _, err := allowlist.Validate("foo"); !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	allowlist.Add("foo", "bar")
+
+	hs, err := allowlist.V("foo")
+	if "foo" != hs.String() || err != nil || !allowlist.Contains("bar") {
+		t.Fail()
+	}
+
+	snapshot := allowlist.Snapshot()
+
+	allowlist.Remove("foo")
+
+	if allowlist.Contains("foo") || !snapshot.Contains("foo") {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"bar"}, allowlist.Items()) {
+		t.Fail()
+	}
+}
+
+func TestMutableAllowlistConcurrent(t *testing.T) {
+	allowlist := NewMutableAllowlist("id")
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		for j := 0; j < 1000; j++ {
+			allowlist.Add("name")
+			allowlist.Remove("name")
+		}
+	}()
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 1000; j++ {
+				if hs, err := allowlist.Validate("id"); "id" != hs.String() || err != nil {
+					t.Error(hs, err)
+					return
+				}
+
+				allowlist.Contains("name")
+			}
+		}()
+	}
+
+	wg.Wait()
+}
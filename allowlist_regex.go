@@ -0,0 +1,67 @@
+package hotcoal
+
+import "regexp"
+
+// regexAllowlistT holds a regular expression, which is used to validate string variables
+// such as column names or table names, when they can't be enumerated in an allowlist
+type regexAllowlistT struct {
+	regexp *regexp.Regexp
+}
+
+// AllowlistRegex creates a regexAllowlistT, which validates string variables against
+// the regular expression pattern. The whole value must match the pattern, e.g.
+//
+//	hotcoal.AllowlistRegex("[a-z_][a-z0-9_]*")
+//
+// Please make the pattern as strict as possible,
+// every value it matches ends up in your SQL.
+// If the pattern can't be parsed, it returns an error.
+func AllowlistRegex(pattern string) (regexAllowlistT, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return regexAllowlistT{}, err
+	}
+
+	return regexAllowlistT{regexp: re}, nil
+}
+
+// The Validate method validates a string variable against the regular expression
+// and returns a hotcoalString.
+// If the value doesn't match, it returns a *ValidationError.
+//
+//hotcoal:boundary
+func (a regexAllowlistT) Validate(value string) (hotcoalString, error) {
+	if a.regexp != nil && a.regexp.MatchString(value) {
+		return hotcoalString(value), nil
+	}
+
+	return "", &ValidationError{Value: value}
+}
+
+// The V method is an shorthand for Validate
+//
+//hotcoal:boundary
+func (a regexAllowlistT) V(value string) (hotcoalString, error) {
+	return a.Validate(value)
+}
+
+// The MustValidate method validates a string variable against the regular expression
+// and returns a hotcoalString.
+// If the value doesn't match, it panics.
+//
+//hotcoal:boundary
+func (a regexAllowlistT) MustValidate(value string) hotcoalString {
+	ret, err := a.Validate(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// The MV method is an shorthand for MustValidate
+//
+//hotcoal:boundary
+func (a regexAllowlistT) MV(value string) hotcoalString {
+	return a.MustValidate(value)
+}
@@ -0,0 +1,81 @@
+package hotcoal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAllowlistRegex(t *testing.T) {
+	allowlist, err := AllowlistRegex("[a-z_][a-z0-9_]*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, el := range []string{"foo", "tenant_42", "_bar"} {
+		hs, err := allowlist.Validate(el)
+		if el != hs.String() || err != nil {
+			t.Fail()
+		}
+
+		hs, err = allowlist.V(el)
+		if el != hs.String() || err != nil {
+			t.Fail()
+		}
+
+		if el != allowlist.MustValidate(el).String() || el != allowlist.MV(el).String() {
+			t.Fail()
+		}
+	}
+}
+
+func TestAllowlistRegexError(t *testing.T) {
+	allowlist, err := AllowlistRegex("[a-z_][a-z0-9_]*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, el := range []string{"", "42foo", "Foo", "foo; DROP TABLE users", "foo\nbar", "x baz"} {
+		hs, err := allowlist.Validate(el)
+		if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+			t.Errorf("%q should not be valid", el)
+		}
+
+		hs, err = allowlist.V(el)
+		if "" != hs.String() || err == nil {
+			t.Fail()
+		}
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+
+			allowlist.MV(el)
+		}()
+	}
+
+	// the alternation is anchored as a whole
+	alternation, err := AllowlistRegex("foo|bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, el := range []string{"foox", "xbar", "foo bar"} {
+		if _, err := alternation.Validate(el); err == nil {
+			t.Errorf("%q should not be valid", el)
+		}
+	}
+
+	hs, err := regexAllowlistT{}.Validate("foo")
+	if "" != hs.String() || err == nil {
+		t.Fail()
+	}
+}
+
+func TestAllowlistRegexInvalidPattern(t *testing.T) {
+	if _, err := AllowlistRegex("[a-z"); err == nil {
+		t.Fail()
+	}
+}
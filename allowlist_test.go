@@ -1,6 +1,8 @@
 package hotcoal
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -30,6 +32,71 @@ func TestAllowlist(t *testing.T) {
 	}
 }
 
+func TestAllowlistValidateReturnsStoredEntry(t *testing.T) {
+	hs, err := AllowlistFold("first_name").Validate("FIRST_NAME")
+	if "first_name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = AllowlistMap(Map{"name": "full_name"}).Validate("name")
+	if "full_name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs = AllowlistFold("first_name").ValidateOrDefault("First_Name", "last_name")
+	if "first_name" != hs.String() {
+		t.Fail()
+	}
+}
+
+func TestAllowlistValidateHotcoal(t *testing.T) {
+	columns := Allowlist("first_name", "last_name", "created_at")
+	sortable := Allowlist("last_name", "created_at")
+
+	hs, err := sortable.ValidateHotcoal(columns.MV("last_name"))
+	if "last_name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = sortable.ValidateHotcoal(columns.MV("first_name"))
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
+func TestAllowlistValidateOrDefault(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name", "created_at")
+
+	if "last_name" != allowlist.ValidateOrDefault("last_name", "created_at").String() {
+		t.Fail()
+	}
+
+	for _, el := range []string{"", "password", "created_at DESC"} {
+		if "created_at" != allowlist.ValidateOrDefault(el, "created_at").String() {
+			t.Fail()
+		}
+	}
+}
+
+func TestAllowlistValidateAs(t *testing.T) {
+	allowlist := AllowlistMap(Map{"first_name": "first_name", "name": "full_name"})
+
+	hs, err := allowlist.ValidateAs("first_name", "name")
+	if "first_name AS name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = allowlist.ValidateAs("name", "name")
+	if "full_name AS name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = allowlist.ValidateAs("password", "name")
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
 func TestAllowlistError(t *testing.T) {
 	allowlist := Allowlist("foo", "bar", "tar")
 	el := "baz"
@@ -70,3 +137,454 @@ func TestAllowlistError(t *testing.T) {
 		hs = allowlist.MV(el)
 	}()
 }
+
+func TestAllowlistFold(t *testing.T) {
+	allowlist := AllowlistFold("first_name", "last_name", "kelvin")
+
+	for _, el := range []string{"first_name", "FIRST_NAME", "First_Name", "fIrSt_NaMe"} {
+		hs, err := allowlist.Validate(el)
+		if "first_name" != hs.String() || err != nil {
+			t.Fail()
+		}
+	}
+
+	// U+212A KELVIN SIGN folds to k
+	hs, err := allowlist.Validate("Kelvin")
+	if "kelvin" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	for _, el := range []string{"middle_name", "first_name ", ""} {
+		hs, err := allowlist.Validate(el)
+		if "" != hs.String() || err == nil {
+			t.Fail()
+		}
+	}
+
+	// exact match is not case-insensitive by default
+	hs, err = Allowlist("first_name").Validate("FIRST_NAME")
+	if "" != hs.String() || err == nil {
+		t.Fail()
+	}
+}
+
+func TestAllowlistFoldTurkishI(t *testing.T) {
+	allowlist := AllowlistFold("id", "ID_CARD")
+
+	// U+0130 LATIN CAPITAL LETTER I WITH DOT ABOVE and U+0131 LATIN SMALL LETTER DOTLESS I
+	// do not fold to the ASCII i and I
+	for _, el := range []string{"İd", "ıd", "İD_CARD", "ıd_card"} {
+		hs, err := allowlist.Validate(el)
+		if "" != hs.String() || err == nil {
+			t.Fail()
+		}
+	}
+
+	for _, el := range []string{"Id", "iD", "ID"} {
+		hs, err := allowlist.Validate(el)
+		if "id" != hs.String() || err != nil {
+			t.Fail()
+		}
+	}
+
+	hs, err := allowlist.Validate("id_card")
+	if "ID_CARD" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestAllowlistFoldAmbiguous(t *testing.T) {
+	allowlist := AllowlistFold("name", "NAME", "Name")
+
+	for _, el := range []string{"name", "NAME", "Name"} {
+		hs, err := allowlist.Validate(el)
+		if el != hs.String() || err != nil {
+			t.Fail()
+		}
+	}
+
+	hs, err := allowlist.Validate("nAmE")
+	if "NAME" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestAllowlistContains(t *testing.T) {
+	allowlist := Allowlist("foo", "bar", "tar")
+
+	for _, el := range []string{"foo", "bar", "tar"} {
+		if !allowlist.Contains(el) {
+			t.Fail()
+		}
+	}
+
+	for _, el := range []string{"baz", "FOO", ""} {
+		if allowlist.Contains(el) {
+			t.Fail()
+		}
+	}
+
+	if !AllowlistFold("foo").Contains("FOO") {
+		t.Fail()
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		allowlist.Contains("foo")
+		allowlist.Contains("baz")
+	})
+
+	if allocs != 0 {
+		t.Fail()
+	}
+}
+
+func TestAllowlistItems(t *testing.T) {
+	allowlist := Allowlist("foo", "bar", "tar", "bar")
+
+	items := allowlist.Items()
+	if !reflect.DeepEqual([]string{"bar", "foo", "tar"}, items) {
+		t.Fail()
+	}
+
+	items[0] = "baz"
+	if allowlist.Contains("baz") || !allowlist.Contains("bar") {
+		t.Fail()
+	}
+
+	hotcoalItems := allowlist.HotcoalItems()
+	if !reflect.DeepEqual(Slice{"bar", "foo", "tar"}, hotcoalItems) {
+		t.Fail()
+	}
+
+	hotcoalItems[0] = "baz"
+	if allowlist.Contains("baz") || !allowlist.Contains("bar") {
+		t.Fail()
+	}
+}
+
+func TestAllowlistWith(t *testing.T) {
+	allowlist := Allowlist("foo", "bar")
+	derived := allowlist.With("tar", "baz")
+
+	if !reflect.DeepEqual([]string{"bar", "foo"}, allowlist.Items()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"bar", "baz", "foo", "tar"}, derived.Items()) {
+		t.Fail()
+	}
+
+	if !AllowlistFold("foo").With("bar").Contains("BAR") {
+		t.Fail()
+	}
+}
+
+func TestAllowlistWithout(t *testing.T) {
+	allowlist := Allowlist("foo", "bar", "tar")
+	derived := allowlist.Without("bar", "baz")
+
+	if !reflect.DeepEqual([]string{"bar", "foo", "tar"}, allowlist.Items()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"foo", "tar"}, derived.Items()) {
+		t.Fail()
+	}
+
+	hs, err := derived.Validate("bar")
+	if "" != hs.String() || err == nil {
+		t.Fail()
+	}
+
+	if !AllowlistFold("foo", "bar").Without("bar").Contains("FOO") {
+		t.Fail()
+	}
+}
+
+func TestAllowlistFilter(t *testing.T) {
+	allowlist := Allowlist("id", "user_id", "name", "group_id")
+	derived := allowlist.Filter(func(item hotcoalString) bool { return item.HasSuffix("_id") })
+
+	if !reflect.DeepEqual([]string{"group_id", "id", "name", "user_id"}, allowlist.Items()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"group_id", "user_id"}, derived.Items()) {
+		t.Fail()
+	}
+
+	if !allowlist.Filter(func(hotcoalString) bool { return true }).Contains("name") {
+		t.Fail()
+	}
+
+	if 4 != allowlist.Filter(func(hotcoalString) bool { return true }).Size() {
+		t.Fail()
+	}
+
+	if !allowlist.Filter(func(hotcoalString) bool { return false }).IsEmpty() {
+		t.Fail()
+	}
+
+	mapped := AllowlistMap(Map{"user": "user_id", "name": "full_name"}).Filter(func(item hotcoalString) bool { return item.HasSuffix("_id") })
+	if !reflect.DeepEqual([]string{"user"}, mapped.Items()) {
+		t.Fail()
+	}
+
+	if !AllowlistFold("user_id", "name").Filter(func(item hotcoalString) bool { return item != "name" }).Contains("USER_ID") {
+		t.Fail()
+	}
+}
+
+func TestUnion(t *testing.T) {
+	users := Allowlist("id", "first_name", "last_name")
+	orders := Allowlist("id", "user_id", "total")
+
+	union := Union(users, orders)
+
+	if !reflect.DeepEqual([]string{"first_name", "id", "last_name", "total", "user_id"}, union.Items()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"first_name", "id", "last_name"}, users.Items()) {
+		t.Fail()
+	}
+
+	if union.Contains("ID") || !Union(AllowlistFold("id"), AllowlistFold("total")).Contains("ID") {
+		t.Fail()
+	}
+
+	if Union(AllowlistFold("id"), orders).Contains("ID") {
+		t.Fail()
+	}
+
+	if Union().Contains("") || len(Union().Items()) != 0 {
+		t.Fail()
+	}
+}
+
+func TestNewAllowlist(t *testing.T) {
+	allowlist := NewAllowlist("foo", "bar")
+
+	hs, err := allowlist.Validate("bar")
+	if "bar" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = allowlist.Validate("tar")
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	allowlist = NewAllowlist()
+
+	for _, el := range []string{"", "foo"} {
+		hs, err := allowlist.Validate(el)
+		if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+			t.Fail()
+		}
+	}
+
+	if 0 != len(allowlist.Items()) {
+		t.Fail()
+	}
+}
+
+func TestAllowlistSize(t *testing.T) {
+	if 0 != NewAllowlist().Size() || !NewAllowlist().IsEmpty() || !AllowlistFromSlice(nil).IsEmpty() {
+		t.Fail()
+	}
+
+	allowlist := Allowlist("foo", "bar", "foo")
+	if 2 != allowlist.Size() || allowlist.IsEmpty() {
+		t.Fail()
+	}
+
+	if 1 != allowlist.Without("foo").Size() || !allowlist.Without("foo", "bar").IsEmpty() {
+		t.Fail()
+	}
+
+	if 3 != AllowlistMap(Map{"a": "x", "b": "x", "c": "y"}).Size() {
+		t.Fail()
+	}
+}
+
+func TestAllowlistFromSlice(t *testing.T) {
+	columns := Slice{"foo", "bar", "tar", "bar"}
+	allowlist := AllowlistFromSlice(columns)
+
+	if !reflect.DeepEqual([]string{"bar", "foo", "tar"}, allowlist.Items()) {
+		t.Fail()
+	}
+
+	hs, err := allowlist.Validate("bar")
+	if "bar" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	columns[0] = "baz"
+	if allowlist.Contains("baz") || !allowlist.Contains("foo") {
+		t.Fail()
+	}
+
+	for _, empty := range []Slice{nil, {}} {
+		allowlist := AllowlistFromSlice(empty)
+
+		hs, err := allowlist.Validate("")
+		if "" != hs.String() || err == nil {
+			t.Fail()
+		}
+
+		if len(allowlist.Items()) != 0 {
+			t.Fail()
+		}
+	}
+}
+
+func TestAllowlistValidateAll(t *testing.T) {
+	allowlist := Allowlist("foo", "bar", "tar")
+	values := []string{"tar", "foo", "tar"}
+
+	s, err := allowlist.ValidateAll(values)
+	if !reflect.DeepEqual(Slice{"tar", "foo", "tar"}, s) || err != nil {
+		t.Fail()
+	}
+
+	s, err = allowlist.VA(values)
+	if !reflect.DeepEqual(Slice{"tar", "foo", "tar"}, s) || err != nil {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"tar", "foo", "tar"}, allowlist.MustValidateAll(values)) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"tar", "foo", "tar"}, allowlist.MVA(values)) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{}, allowlist.MVA(nil)) {
+		t.Fail()
+	}
+}
+
+func TestAllowlistValidateAllCollecting(t *testing.T) {
+	allowlist := Allowlist("id", "first_name", "last_name")
+
+	s, errs := allowlist.ValidateAllCollecting([]string{"id", "password", "last_name", "salt"})
+	if !reflect.DeepEqual(Slice{"id", "last_name"}, s) || 2 != len(errs) {
+		t.Fatal(s, errs)
+	}
+
+	for i, value := range []string{"password", "salt"} {
+		var validationError *ValidationError
+		if !errors.As(errs[i], &validationError) || value != validationError.Value {
+			t.Fail()
+		}
+	}
+
+	s, errs = allowlist.ValidateAllCollecting([]string{"first_name", "id"})
+	if !reflect.DeepEqual(Slice{"first_name", "id"}, s) || errs != nil {
+		t.Fail()
+	}
+}
+
+func TestAllowlistValidateAllError(t *testing.T) {
+	allowlist := Allowlist("foo", "bar", "tar")
+	values := []string{"foo", "baz", "qux"}
+
+	s, err := allowlist.ValidateAll(values)
+	if s != nil || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) || "baz" != validationError.Value {
+		t.Fail()
+	}
+
+	s, err = allowlist.VA(values)
+	if s != nil || err == nil {
+		t.Fail()
+	}
+
+	for _, validate := range []func([]string) Slice{allowlist.MustValidateAll, allowlist.MVA} {
+		func() {
+			var s Slice
+
+			defer func() {
+				p := recover()
+				if s != nil || p == nil {
+					t.Fail()
+				}
+			}()
+
+			s = validate(values)
+		}()
+	}
+}
+
+func TestAllowlistMap(t *testing.T) {
+	m := Map{
+		"name":       "full_name",
+		"full_name":  "full_name",
+		"created":    "created_at",
+		"created_at": "created_at",
+	}
+
+	allowlist := AllowlistMap(m)
+
+	for value, expected := range m {
+		hs, err := allowlist.Validate(value)
+		if expected != hs || err != nil {
+			t.Fail()
+		}
+	}
+
+	m["id"] = "id"
+	if allowlist.Contains("id") {
+		t.Fail()
+	}
+
+	hs, err := allowlist.Validate("full_name; DROP TABLE users")
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"created", "created_at", "full_name", "name"}, allowlist.Items()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"created_at", "full_name"}, allowlist.HotcoalItems()) {
+		t.Fail()
+	}
+
+	if AllowlistMap(nil).Contains("") || AllowlistMap(Map{}).Contains("") {
+		t.Fail()
+	}
+}
+
+func TestAllowlistMapDerived(t *testing.T) {
+	allowlist := AllowlistMap(Map{"name": "full_name", "created": "created_at"})
+
+	if !reflect.DeepEqual([]string{"created", "id", "name"}, allowlist.With("id").Items()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"created"}, allowlist.Without("full_name").Items()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]string{"created"}, allowlist.Without("name").Items()) {
+		t.Fail()
+	}
+
+	union := Union(allowlist, AllowlistMap(Map{"name": "nickname"}))
+
+	if hs := union.MV("name"); "nickname" != hs.String() {
+		t.Fail()
+	}
+
+	if hs := union.MV("created"); "created_at" != hs.String() {
+		t.Fail()
+	}
+}
@@ -2,6 +2,8 @@ package hotcoal
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 )
 
@@ -14,6 +16,16 @@ type Builder struct {
 	stringBuilder strings.Builder
 }
 
+// NewBuilder creates a Builder with space for at least capacity bytes,
+// so you can avoid reallocations when you know the approximate size of the result.
+// If capacity is negative, NewBuilder panics.
+func NewBuilder(capacity int) *Builder {
+	b := &Builder{}
+	b.Grow(capacity)
+
+	return b
+}
+
 // Cap returns the capacity of the builder's underlying byte slice. It is the
 // total space allocated for the hotcoalString being built and includes any bytes
 // already written.
@@ -28,6 +40,14 @@ func (b *Builder) Grow(n int) {
 	b.stringBuilder.Grow(n)
 }
 
+// GrowC is the same as Grow, but it returns b, you can chain method calls,
+// e.g. b.ResetC().GrowC(64).Write("SELECT ")
+func (b *Builder) GrowC(n int) *Builder {
+	b.Grow(n)
+
+	return b
+}
+
 // Len returns the number of accumulated bytes; b.Len() == len(b.String()).
 func (b *Builder) Len() int {
 	return b.stringBuilder.Len()
@@ -38,6 +58,31 @@ func (b *Builder) Reset() {
 	b.stringBuilder.Reset()
 }
 
+// ResetC is the same as Reset, but it returns b, you can chain method calls.
+func (b *Builder) ResetC() *Builder {
+	b.Reset()
+
+	return b
+}
+
+// Truncate discards all but the first n bytes of the accumulated bytes,
+// e.g. a trailing separator. If n is negative or greater than b.Len(), Truncate panics.
+// The strings.Builder can't be truncated, so Truncate copies the first n bytes to a new buffer.
+// It returns b, you can chain method calls.
+func (b *Builder) Truncate(n int) *Builder {
+	if n < 0 || n > b.Len() {
+		panic(fmt.Sprintf("Hotcoal Builder.Truncate received n %d out of range [0:%d]", n, b.Len()))
+	}
+
+	// the strings.Builder allocates a new buffer after Reset, so prefix stays valid
+	prefix := b.stringBuilder.String()[:n]
+
+	b.stringBuilder.Reset()
+	b.stringBuilder.WriteString(prefix)
+
+	return b
+}
+
 // Write appends the contents of s to b's buffer. It returns b, you can chain method calls.
 func (b *Builder) Write(s hotcoalString) *Builder {
 	_, err := b.stringBuilder.WriteString(string(s))
@@ -50,12 +95,169 @@ func (b *Builder) Write(s hotcoalString) *Builder {
 	return b
 }
 
+// WriteConst appends the contents of s to b's buffer, it's the same as Write.
+// It makes it clear that s is an untyped string constant, e.g. b.WriteConst(" WHERE ").
+// It returns b, you can chain method calls.
+func (b *Builder) WriteConst(s hotcoalString) *Builder {
+	return b.Write(s)
+}
+
+// WriteAll appends the contents of parts to b's buffer, e.g. for fixed boilerplate.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteAll(parts ...hotcoalString) *Builder {
+	for _, el := range parts {
+		b.Write(el)
+	}
+
+	return b
+}
+
+// WriteIf appends the contents of s to b's buffer, if cond is true.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteIf(cond bool, s hotcoalString) *Builder {
+	if cond {
+		b.Write(s)
+	}
+
+	return b
+}
+
+// WriteIfElse appends the contents of ifTrue to b's buffer if cond is true,
+// otherwise it appends the contents of ifFalse.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteIfElse(cond bool, ifTrue, ifFalse hotcoalString) *Builder {
+	if cond {
+		return b.Write(ifTrue)
+	}
+
+	return b.Write(ifFalse)
+}
+
+// WriteByte appends the byte c to b's buffer.
+// Unlike Write, it returns an error, which is always nil,
+// so Builder implements io.ByteWriter https://pkg.go.dev/io#ByteWriter
+func (b *Builder) WriteByte(c byte) error {
+	return b.stringBuilder.WriteByte(c)
+}
+
+// WriteRune appends the UTF-8 encoding of Unicode code point r to b's buffer.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteRune(r rune) *Builder {
+	_, err := b.stringBuilder.WriteRune(r)
+
+	if err != nil {
+		// this shouldn't happen, the strings.Builder returns nil error
+		panic(fmt.Sprintf("Hotcoal Builder.WriteRune received error: %#v", err))
+	}
+
+	return b
+}
+
+// WriteInt appends the decimal representation of i to b's buffer.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteInt(i int) *Builder {
+	return b.Write(Itoa(i))
+}
+
+// WriteFloat appends the floating-point number f to b's buffer, formatted according to
+// the format and precision, see strconv.FormatFloat https://pkg.go.dev/strconv#FormatFloat
+// Please note that NaN and infinities are written as NaN, +Inf and -Inf, which are not SQL numbers.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteFloat(f float64, format byte, prec, bitSize int) *Builder {
+	var buf [32]byte
+
+	b.stringBuilder.Write(strconv.AppendFloat(buf[:0], f, format, prec, bitSize))
+
+	return b
+}
+
+// WriteBool appends "true" or "false" to b's buffer, according to the value of v.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteBool(v bool) *Builder {
+	var buf [5]byte
+
+	b.stringBuilder.Write(strconv.AppendBool(buf[:0], v))
+
+	return b
+}
+
+// WriteIntSlice appends the decimal representations of ints to b's buffer,
+// with the separator sep placed between them.
+// It doesn't allocate intermediate strings, so it's efficient for long IN lists.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteIntSlice(ints []int, sep hotcoalString) *Builder {
+	var buf [20]byte
+
+	for i, el := range ints {
+		if i != 0 {
+			b.Write(sep)
+		}
+
+		b.stringBuilder.Write(strconv.AppendInt(buf[:0], int64(el), 10))
+	}
+
+	return b
+}
+
+// WriteJoin appends the elements of elems to b's buffer, with the separator sep
+// placed between them. It returns b, you can chain method calls.
+func (b *Builder) WriteJoin(elems Slice, sep hotcoalString) *Builder {
+	return b.Write(Join(elems, sep))
+}
+
+// WriteList appends open, the elements of elems separated by sep, and close to b's buffer,
+// e.g. WriteList("(", ", ", ")", columns) appends "(a, b, c)".
+// If elems is empty, it appends open and close, e.g. "()".
+// It returns b, you can chain method calls.
+func (b *Builder) WriteList(open, sep, close hotcoalString, elems Slice) *Builder {
+	return b.Write(open).WriteJoin(elems, sep).Write(close)
+}
+
+// WriteRepeat appends count copies of s to b's buffer,
+// without building an intermediate string. If count is negative, WriteRepeat panics.
+// It returns b, you can chain method calls.
+func (b *Builder) WriteRepeat(s hotcoalString, count int) *Builder {
+	if count < 0 {
+		panic("Hotcoal Builder.WriteRepeat received negative count")
+	}
+
+	if count > 0 && len(s)*count/count != len(s) {
+		panic("Hotcoal Builder.WriteRepeat output length overflows")
+	}
+
+	b.stringBuilder.Grow(len(s) * count)
+
+	for i := 0; i < count; i++ {
+		b.stringBuilder.WriteString(string(s))
+	}
+
+	return b
+}
+
 // String returns the accumulated string as a hotcoalString.
 func (b *Builder) HotcoalString() hotcoalString {
 	return hotcoalString(b.String())
 }
 
 // String returns the accumulated string as a plain string.
+//
+//hotcoal:boundary
 func (b *Builder) String() string {
 	return b.stringBuilder.String()
 }
+
+// Bytes returns the accumulated bytes.
+// The strings.Builder doesn't expose its buffer, so Bytes returns a copy,
+// which you can modify without affecting the Builder.
+func (b *Builder) Bytes() []byte {
+	return []byte(b.stringBuilder.String())
+}
+
+// WriteTo writes the accumulated bytes to w, so Builder implements io.WriterTo https://pkg.go.dev/io#WriterTo
+// If w implements io.StringWriter, the bytes are not copied, otherwise they are converted to a []byte,
+// see io.WriteString https://pkg.go.dev/io#WriteString
+func (b *Builder) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, b.stringBuilder.String())
+
+	return int64(n), err
+}
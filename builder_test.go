@@ -1,6 +1,13 @@
 package hotcoal
 
-import "testing"
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"testing"
+)
 
 func TestBuilder(t *testing.T) {
 	var b Builder
@@ -30,3 +37,382 @@ func TestBuilder(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestBuilderChainGrowReset(t *testing.T) {
+	var b Builder
+
+	b.Write("foo").ResetC().GrowC(64).Write("SELECT ").Write("*")
+
+	if "SELECT *" != b.String() || b.Cap() != 64 {
+		t.Fail()
+	}
+
+	if &b != b.ResetC() || &b != b.GrowC(0) {
+		t.Fail()
+	}
+}
+
+func TestBuilderTruncate(t *testing.T) {
+	var b Builder
+
+	for _, el := range []hotcoalString{"a", "b", "c"} {
+		b.Write(el).Write(", ")
+	}
+
+	if "a, b, c" != b.Truncate(b.Len()-2).String() {
+		t.Fail()
+	}
+
+	if "a, b, c" != b.Truncate(b.Len()).String() || "a, b" != b.Truncate(4).Write("").String() {
+		t.Fail()
+	}
+
+	if "" != b.Truncate(0).String() || 0 != b.Len() {
+		t.Fail()
+	}
+
+	b.Write("foo")
+
+	for _, n := range []int{-1, 4} {
+		func() {
+			defer func() {
+				if recover() == nil || "foo" != b.String() {
+					t.Fail()
+				}
+			}()
+
+			b.Truncate(n)
+		}()
+	}
+}
+
+func TestBuilderWriteConst(t *testing.T) {
+	var b Builder
+
+	if "SELECT * FROM users" != b.WriteConst("SELECT *").WriteConst(" FROM users").String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteAll(t *testing.T) {
+	var b Builder
+
+	columns := Allowlist("first_name", "last_name")
+
+	b.WriteAll("SELECT ", columns.MV("last_name"), " FROM users WHERE ", columns.MV("first_name"), " = ?")
+
+	if "SELECT last_name FROM users WHERE first_name = ?" != b.String() {
+		t.Fail()
+	}
+
+	if "SELECT last_name FROM users WHERE first_name = ?" != b.WriteAll().String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteByte(t *testing.T) {
+	var b Builder
+
+	b.Write("(")
+
+	if err := b.WriteByte('?'); err != nil {
+		t.Fail()
+	}
+
+	b.WriteByte(',')
+	b.WriteByte(')')
+
+	if "(?,)" != b.String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteRune(t *testing.T) {
+	var b Builder
+
+	b.WriteRune('(').WriteRune('?').WriteRune('€').WriteRune(')')
+
+	if "(?€)" != b.String() || b.Len() != 6 {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteInt(t *testing.T) {
+	var b Builder
+
+	b.Write("LIMIT ").WriteInt(10).Write(" OFFSET ").WriteInt(-20)
+
+	if "LIMIT 10 OFFSET -20" != b.String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteJoin(t *testing.T) {
+	var b Builder
+
+	b.Write("SELECT ").WriteJoin(Slice{"foo", "bar", "tar"}, ", ").Write(" FROM users").WriteJoin(Slice{}, ", ")
+
+	if "SELECT foo, bar, tar FROM users" != b.String() {
+		t.Fail()
+	}
+}
+
+func TestNewBuilder(t *testing.T) {
+	b := NewBuilder(2048)
+
+	if b.Len() != 0 || b.Cap() < 2048 {
+		t.Fail()
+	}
+
+	b.Write("foo")
+
+	if "foo" != b.String() || b.Cap() < 2048 {
+		t.Fail()
+	}
+
+	if b := NewBuilder(0); b.Len() != 0 || b.Cap() != 0 {
+		t.Fail()
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+
+		NewBuilder(-1)
+	}()
+}
+
+func TestBuilderWriteIf(t *testing.T) {
+	var b Builder
+
+	b.Write("SELECT * FROM users").
+		WriteIf(true, " WHERE first_name = ?").
+		WriteIf(false, " AND last_name = ?").
+		Write(";")
+
+	if "SELECT * FROM users WHERE first_name = ?;" != b.String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteIfElse(t *testing.T) {
+	var b Builder
+
+	b.Write("ORDER BY id").
+		WriteIfElse(true, " DESC", " ASC").
+		Write(", name").
+		WriteIfElse(false, " DESC", " ASC")
+
+	if "ORDER BY id DESC, name ASC" != b.String() {
+		t.Fail()
+	}
+}
+
+var _ fmt.Stringer = &Builder{}
+
+func TestBuilderStringer(t *testing.T) {
+	var b Builder
+
+	b.Write("foo")
+
+	if "foo" != fmt.Sprint(&b) {
+		t.Fail()
+	}
+}
+
+func TestBuilderBytes(t *testing.T) {
+	var b Builder
+
+	if len(b.Bytes()) != 0 {
+		t.Fail()
+	}
+
+	b.Write("foo").Write("bar")
+
+	bs := b.Bytes()
+	if !bytes.Equal([]byte(b.String()), bs) {
+		t.Fail()
+	}
+
+	bs[0] = 'g'
+	if "foobar" != b.String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteIntSlice(t *testing.T) {
+	var b Builder
+
+	// the extremes of int, which is 32 or 64 bits wide
+	maxInt := int(^uint(0) >> 1)
+	minInt := -maxInt - 1
+
+	b.Write("id IN (").WriteIntSlice([]int{1, -22, 333, maxInt, minInt}, ", ").Write(")")
+
+	if "id IN (1, -22, 333, "+strconv.Itoa(maxInt)+", "+strconv.Itoa(minInt)+")" != b.String() {
+		t.Fail()
+	}
+
+	b.Reset()
+	b.WriteIntSlice(nil, ", ").WriteIntSlice([]int{7}, ", ")
+
+	if "7" != b.String() {
+		t.Fail()
+	}
+}
+
+var benchmarkInts = func() []int {
+	ret := make([]int, 1000)
+	for i := range ret {
+		ret[i] = i * 1000
+	}
+
+	return ret
+}()
+
+func BenchmarkBuilderWriteIntSlice(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var builder Builder
+		builder.WriteIntSlice(benchmarkInts, ", ")
+	}
+}
+
+func BenchmarkBuilderItoaJoin(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var builder Builder
+
+		s := make(Slice, 0, len(benchmarkInts))
+		for _, el := range benchmarkInts {
+			s = append(s, Itoa(el))
+		}
+
+		builder.Write(Join(s, ", "))
+	}
+}
+
+func TestBuilderWriteList(t *testing.T) {
+	var b Builder
+
+	b.Write("INSERT INTO users ").WriteList("(", ", ", ")", Slice{"first_name", "last_name"})
+
+	if "INSERT INTO users (first_name, last_name)" != b.String() {
+		t.Fail()
+	}
+
+	b.ResetC().WriteList("(", ", ", ")", nil).WriteList("[", "", "]", Slice{"a"})
+
+	if "()[a]" != b.String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteRepeat(t *testing.T) {
+	var b Builder
+
+	b.Write("VALUES ").WriteRepeat("(?), ", 3).WriteRepeat("x", 0)
+
+	if "VALUES (?), (?), (?), " != b.String() {
+		t.Fail()
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fail()
+		}
+	}()
+
+	b.WriteRepeat("?", -1)
+}
+
+func BenchmarkBuilderWriteRepeat(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var builder Builder
+		builder.WriteRepeat("?, ", 1000)
+	}
+}
+
+func BenchmarkBuilderWriteAndRepeat(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var builder Builder
+		builder.Write(W("?, ").Repeat(1000))
+	}
+}
+
+var _ io.WriterTo = &Builder{}
+
+func TestBuilderWriteTo(t *testing.T) {
+	var b Builder
+	b.Write("SELECT * FROM users WHERE id = ?")
+
+	var buf bytes.Buffer
+
+	n, err := b.WriteTo(&buf)
+	if b.String() != buf.String() || int64(b.Len()) != n || err != nil {
+		t.Fail()
+	}
+
+	// the Builder is not drained
+	buf.Reset()
+
+	if _, err := b.WriteTo(&buf); b.String() != buf.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteFloat(t *testing.T) {
+	var b Builder
+
+	b.Write("SELECT ").WriteFloat(1.5, 'f', -1, 64).Write(", ").WriteFloat(-0.25, 'f', 3, 64)
+	b.Write(", ").WriteFloat(1e21, 'g', -1, 64).Write(", ").WriteFloat(float64(float32(0.1)), 'f', -1, 32)
+
+	if "SELECT 1.5, -0.250, 1e+21, 0.1" != b.String() {
+		t.Fail()
+	}
+
+	b.ResetC().WriteFloat(math.MaxFloat64, 'f', 0, 64)
+
+	if strconv.FormatFloat(math.MaxFloat64, 'f', 0, 64) != b.String() {
+		t.Fail()
+	}
+}
+
+func TestBuilderWriteBool(t *testing.T) {
+	var b Builder
+
+	b.Write("WHERE is_admin = ").WriteBool(false).Write(" OR is_owner = ").WriteBool(true)
+
+	if "WHERE is_admin = false OR is_owner = true" != b.String() {
+		t.Fail()
+	}
+}
+
+func BenchmarkBuilderWriteFloat(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var builder Builder
+		builder.Grow(32)
+		builder.WriteFloat(3.14159, 'f', -1, 64)
+	}
+}
+
+func BenchmarkBuilderFormatFloatWrite(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var builder Builder
+		builder.Grow(32)
+		builder.Write(hotcoalString(strconv.FormatFloat(3.14159, 'f', -1, 64)))
+	}
+}
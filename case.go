@@ -0,0 +1,51 @@
+package hotcoal
+
+// CaseBuilder is used to build a CASE expression,
+// e.g. "CASE WHEN age < 18 THEN 'minor' ELSE 'adult' END".
+// The zero value is ready to use.
+type CaseBuilder struct {
+	whens   Slice
+	elseSet bool
+	els     hotcoalString
+}
+
+// When adds a WHEN cond THEN result branch. It returns c, you can chain method calls.
+func (c *CaseBuilder) When(cond, result hotcoalString) *CaseBuilder {
+	c.whens = append(c.whens, "WHEN "+cond+" THEN "+result)
+
+	return c
+}
+
+// Else sets the ELSE result, calling it again replaces it.
+// It returns c, you can chain method calls.
+func (c *CaseBuilder) Else(result hotcoalString) *CaseBuilder {
+	c.elseSet = true
+	c.els = result
+
+	return c
+}
+
+// End returns the CASE expression.
+// Without When branches, a CASE expression would be invalid SQL,
+// so it returns the ELSE result, or NULL if there's no ELSE, which is what the CASE would return.
+func (c *CaseBuilder) End() hotcoalString {
+	if len(c.whens) == 0 {
+		if c.elseSet {
+			return c.els
+		}
+
+		return "NULL"
+	}
+
+	var b Builder
+
+	b.Write("CASE ").WriteJoin(c.whens, " ")
+
+	if c.elseSet {
+		b.Write(" ELSE ").Write(c.els)
+	}
+
+	b.Write(" END")
+
+	return b.HotcoalString()
+}
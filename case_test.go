@@ -0,0 +1,31 @@
+package hotcoal
+
+import "testing"
+
+func TestCaseBuilder(t *testing.T) {
+	var c CaseBuilder
+
+	c.When("age < 13", "'child'").When("age < 18", "'teenager'").Else("'adult'")
+
+	if "CASE WHEN age < 13 THEN 'child' WHEN age < 18 THEN 'teenager' ELSE 'adult' END" != c.End().String() {
+		t.Fail()
+	}
+
+	var d CaseBuilder
+
+	if "CASE WHEN deleted_at IS NULL THEN 1 END" != d.When("deleted_at IS NULL", "1").End().String() {
+		t.Fail()
+	}
+}
+
+func TestCaseBuilderWithoutWhen(t *testing.T) {
+	var c CaseBuilder
+
+	if "NULL" != c.End().String() {
+		t.Fail()
+	}
+
+	if "0" != c.Else("0").End().String() {
+		t.Fail()
+	}
+}
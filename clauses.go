@@ -0,0 +1,168 @@
+package hotcoal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OrderSpec is a column to order by, see OrderBy
+type OrderSpec struct {
+	Column string
+	Desc   bool
+}
+
+// OrderBy validates the columns of the specs against the allowlist and returns an ORDER BY clause,
+// e.g. "ORDER BY last_name ASC, created_at DESC".
+// The directions are always ASC or DESC, they don't come from user input.
+// If there are no specs, it returns an empty hotcoalString.
+// If any column is not in the allowlist, it returns the error of the first one.
+func OrderBy(allowlist allowlistT, specs ...OrderSpec) (hotcoalString, error) {
+	if len(specs) == 0 {
+		return "", nil
+	}
+
+	columns := make(Slice, 0, len(specs))
+
+	for _, spec := range specs {
+		column, err := allowlist.Validate(spec.Column)
+		if err != nil {
+			return "", err
+		}
+
+		if spec.Desc {
+			columns = append(columns, column+" DESC")
+		} else {
+			columns = append(columns, column+" ASC")
+		}
+	}
+
+	return "ORDER BY " + columns.Join(", "), nil
+}
+
+// Assignment is a column and its new value, see SetClause
+type Assignment struct {
+	Column string
+	Value  interface{}
+}
+
+// SetClause validates the columns of the assignments against the allowlist and returns
+// the assignments of an UPDATE statement, e.g. "first_name = ?, last_name = ?",
+// and the values as args, in the same order.
+// The values are never written into the SQL, only placeholders.
+// If there are no assignments, it returns an empty hotcoalString and nil args.
+// If any column is not in the allowlist, it returns the error of the first one.
+func SetClause(allowlist allowlistT, assignments ...Assignment) (hotcoalString, []interface{}, error) {
+	if len(assignments) == 0 {
+		return "", nil, nil
+	}
+
+	columns := make(Slice, 0, len(assignments))
+	args := make([]interface{}, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		column, err := allowlist.Validate(assignment.Column)
+		if err != nil {
+			return "", nil, err
+		}
+
+		columns = append(columns, column+" = ?")
+		args = append(args, assignment.Value)
+	}
+
+	return columns.Join(", "), args, nil
+}
+
+// InsertColumns validates the columns against the allowlist and returns the column list
+// and the matching placeholder group of an INSERT statement, e.g. "(first_name, last_name)"
+// and "(?, ?)", and the values as args, in the same order.
+// The values are never written into the SQL, only placeholders.
+// If the number of columns and values differ, or there are no columns, it returns an error.
+// If any column is not in the allowlist, it returns the error of the first one.
+func InsertColumns(allowlist allowlistT, cols []string, values []interface{}) (columnsSQL hotcoalString, placeholders hotcoalString, args []interface{}, err error) {
+	if len(cols) != len(values) {
+		return "", "", nil, fmt.Errorf("Hotcoal insert error - %d columns, but %d values", len(cols), len(values))
+	}
+
+	if len(cols) == 0 {
+		return "", "", nil, errors.New("Hotcoal insert error - no columns")
+	}
+
+	columns, err := allowlist.ValidateAll(cols)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	args = make([]interface{}, len(values))
+	copy(args, values)
+
+	return "(" + columns.Join(", ") + ")", "(" + Placeholders(len(values)) + ")", args, nil
+}
+
+// Returning validates the columns against the allowlist and returns a RETURNING clause,
+// with a leading space, e.g. " RETURNING id, created_at".
+// If there are no columns, it returns an empty hotcoalString.
+// If any column is not in the allowlist, it returns the error of the first one.
+// See Dialect.Returning for SQLServer.
+func Returning(allowlist allowlistT, cols ...string) (hotcoalString, error) {
+	if len(cols) == 0 {
+		return "", nil
+	}
+
+	columns, err := allowlist.ValidateAll(cols)
+	if err != nil {
+		return "", err
+	}
+
+	return " RETURNING " + columns.Join(", "), nil
+}
+
+// Projection validates the columns against the allowlist and returns the column list of a SELECT statement,
+// e.g. "first_name, last_name", so you can select the fields requested by the client.
+// If there are no columns, it returns "*", see ProjectionStrict if you want an error instead.
+// If any column is not in the allowlist, it returns the error of the first one.
+func Projection(allowlist allowlistT, columns ...string) (hotcoalString, error) {
+	if len(columns) == 0 {
+		return "*", nil
+	}
+
+	return ProjectionStrict(allowlist, columns...)
+}
+
+// ProjectionStrict is like Projection, but if there are no columns, it returns an error.
+func ProjectionStrict(allowlist allowlistT, columns ...string) (hotcoalString, error) {
+	if len(columns) == 0 {
+		return "", errors.New("Hotcoal projection error - no columns")
+	}
+
+	items, err := allowlist.ValidateAll(columns)
+	if err != nil {
+		return "", err
+	}
+
+	return items.Join(", "), nil
+}
+
+// LimitOffset returns " LIMIT limit OFFSET offset", with a leading space.
+// A negative limit or offset omits its clause, e.g. LimitOffset(10, -1) returns " LIMIT 10".
+// Please note that LimitOffset(-1, 20) returns " OFFSET 20", which is valid in PostgreSQL,
+// but MySQL and SQLite require a LIMIT before OFFSET, so please pass a limit for them.
+// Since the values are ints, the result is safe.
+func LimitOffset(limit, offset int) hotcoalString {
+	var ret hotcoalString
+
+	if limit >= 0 {
+		ret += " LIMIT " + Itoa(limit)
+	}
+
+	if offset >= 0 {
+		ret += " OFFSET " + Itoa(offset)
+	}
+
+	return ret
+}
+
+// Limit returns " LIMIT limit", with a leading space.
+// A negative limit returns an empty hotcoalString.
+func Limit(limit int) hotcoalString {
+	return LimitOffset(limit, -1)
+}
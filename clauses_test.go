@@ -0,0 +1,175 @@
+package hotcoal
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOrderBy(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name", "created_at")
+
+	hs, err := OrderBy(allowlist, OrderSpec{Column: "last_name"}, OrderSpec{Column: "created_at", Desc: true})
+	if "ORDER BY last_name ASC, created_at DESC" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = OrderBy(allowlist, OrderSpec{Column: "first_name", Desc: true})
+	if "ORDER BY first_name DESC" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = OrderBy(allowlist)
+	if "" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestOrderByError(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name")
+
+	hs, err := OrderBy(allowlist, OrderSpec{Column: "last_name"}, OrderSpec{Column: "(SELECT password FROM users)"})
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
+func TestSetClause(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name", "age")
+
+	hs, args, err := SetClause(allowlist, Assignment{"last_name", "Smith"}, Assignment{"age", 42})
+	if "last_name = ?, age = ?" != hs.String() || !reflect.DeepEqual([]interface{}{"Smith", 42}, args) || err != nil {
+		t.Fail()
+	}
+
+	hs, args, err = SetClause(allowlist)
+	if "" != hs.String() || args != nil || err != nil {
+		t.Fail()
+	}
+}
+
+func TestSetClauseError(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name")
+
+	hs, args, err := SetClause(allowlist, Assignment{"last_name", "Smith"}, Assignment{"is_admin = true, last_name", "Smith"})
+	if "" != hs.String() || args != nil || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
+func TestInsertColumns(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name", "age")
+
+	columns, placeholders, args, err := InsertColumns(allowlist, []string{"last_name", "age"}, []interface{}{"Smith", 42})
+	if "(last_name, age)" != columns.String() || "(?, ?)" != placeholders.String() || err != nil {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]interface{}{"Smith", 42}, args) {
+		t.Fail()
+	}
+}
+
+func TestInsertColumnsError(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name")
+
+	_, _, _, err := InsertColumns(allowlist, []string{"last_name", "is_admin) VALUES (true"}, []interface{}{"Smith", true})
+	if !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	_, _, _, err = InsertColumns(allowlist, []string{"first_name", "last_name"}, []interface{}{"John"})
+	if err == nil || "Hotcoal insert error - 2 columns, but 1 values" != err.Error() {
+		t.Fail()
+	}
+
+	columns, placeholders, args, err := InsertColumns(allowlist, nil, nil)
+	if "" != columns || "" != placeholders || args != nil || err == nil {
+		t.Fail()
+	}
+}
+
+func TestReturning(t *testing.T) {
+	allowlist := Allowlist("id", "created_at")
+
+	hs, err := Returning(allowlist, "id", "created_at")
+	if " RETURNING id, created_at" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = Returning(allowlist)
+	if "" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = Returning(allowlist, "id", "password")
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
+func TestProjection(t *testing.T) {
+	allowlist := AllowlistMap(Map{"first_name": "first_name", "name": "full_name"})
+
+	hs, err := Projection(allowlist, "name", "first_name")
+	if "full_name, first_name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = Projection(allowlist)
+	if "*" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = Projection(allowlist, "first_name", "password")
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
+func TestProjectionStrict(t *testing.T) {
+	allowlist := Allowlist("first_name", "last_name")
+
+	hs, err := ProjectionStrict(allowlist, "last_name")
+	if "last_name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = ProjectionStrict(allowlist)
+	if "" != hs.String() || err == nil || "Hotcoal projection error - no columns" != err.Error() {
+		t.Fail()
+	}
+
+	hs, err = ProjectionStrict(allowlist, "password")
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
+func TestLimitOffset(t *testing.T) {
+	if " LIMIT 10 OFFSET 20" != LimitOffset(10, 20).String() {
+		t.Fail()
+	}
+
+	if " LIMIT 0 OFFSET 0" != LimitOffset(0, 0).String() {
+		t.Fail()
+	}
+
+	if " LIMIT 10" != LimitOffset(10, -1).String() {
+		t.Fail()
+	}
+
+	if " OFFSET 20" != LimitOffset(-1, 20).String() {
+		t.Fail()
+	}
+
+	if "" != LimitOffset(-1, -5).String() {
+		t.Fail()
+	}
+}
+
+func TestLimit(t *testing.T) {
+	if " LIMIT 10" != Limit(10).String() || " LIMIT 0" != Limit(0).String() || "" != Limit(-1).String() {
+		t.Fail()
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// schema maps table names to their column names
+type schema map[string][]string
+
+// readSchema reads a CSV export of information_schema.columns
+func readSchema(r io.Reader) (schema, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("the CSV is empty")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	tableIndex, columnIndex := -1, -1
+
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "table_name":
+			tableIndex = i
+		case "column_name":
+			columnIndex = i
+		}
+	}
+
+	if tableIndex == -1 || columnIndex == -1 {
+		return nil, errors.New("the CSV header must contain table_name and column_name")
+	}
+
+	ret := schema{}
+
+	for n := 1; ; n++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		table, column := record[tableIndex], record[columnIndex]
+		if table == "" || column == "" {
+			return nil, fmt.Errorf("empty table_name or column_name in record %d", n)
+		}
+
+		ret[table] = append(ret[table], column)
+	}
+
+	if len(ret) == 0 {
+		return nil, errors.New("the CSV doesn't contain any columns")
+	}
+
+	return ret, nil
+}
+
+// generate returns the Go source with the allowlists of the schema
+func generate(s schema, pkg string) ([]byte, error) {
+	tables := make([]string, 0, len(s))
+	for table := range s {
+		tables = append(tables, table)
+	}
+
+	sort.Strings(tables)
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by hotcoalgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/motrboat/hotcoal\"\n\n")
+
+	fmt.Fprintf(&buf, "// Tables is the allowlist of the tables\n")
+	writeAllowlist(&buf, "Tables", tables)
+
+	names := map[string]string{"Tables": ""}
+
+	for _, table := range tables {
+		name := exportedName(table) + "Columns"
+
+		if other, ok := names[name]; ok {
+			return nil, fmt.Errorf("tables %q and %q have the same Go name %s", other, table, name)
+		}
+
+		names[name] = table
+
+		columns := dedup(s[table])
+		sort.Strings(columns)
+
+		fmt.Fprintf(&buf, "\n// %s is the allowlist of the columns of the %s table\n", name, table)
+		writeAllowlist(&buf, name, columns)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func writeAllowlist(buf *bytes.Buffer, name string, items []string) {
+	fmt.Fprintf(buf, "var %s = hotcoal.Allowlist(\n", name)
+
+	for _, item := range items {
+		fmt.Fprintf(buf, "\t%s,\n", strconv.Quote(item))
+	}
+
+	fmt.Fprintf(buf, ")\n")
+}
+
+// exportedName converts a table name, e.g. user_accounts, to an exported Go name, e.g. UserAccounts
+func exportedName(table string) string {
+	var b strings.Builder
+
+	upper := true
+
+	for _, r := range table {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if b.Len() == 0 && !unicode.IsUpper(unicode.ToUpper(r)) {
+			// Go names must start with a letter, exported ones with an upper case letter
+			b.WriteString("T")
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		b.WriteRune(r)
+	}
+
+	if b.Len() == 0 {
+		return "T"
+	}
+
+	return b.String()
+}
+
+func dedup(items []string) []string {
+	seen := map[string]bool{}
+	ret := make([]string, 0, len(items))
+
+	for _, el := range items {
+		if !seen[el] {
+			seen[el] = true
+			ret = append(ret, el)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const testCSV = `table_name,column_name
+users,id
+users,first_name
+users,last_name
+user_accounts,user_id
+users,email
+user_accounts,balance
+`
+
+const expectedSource = `// Code generated by hotcoalgen. DO NOT EDIT.
+
+package schema
+
+import "github.com/motrboat/hotcoal"
+
+// Tables is the allowlist of the tables
+var Tables = hotcoal.Allowlist(
+	"user_accounts",
+	"users",
+)
+
+// UserAccountsColumns is the allowlist of the columns of the user_accounts table
+var UserAccountsColumns = hotcoal.Allowlist(
+	"balance",
+	"user_id",
+)
+
+// UsersColumns is the allowlist of the columns of the users table
+var UsersColumns = hotcoal.Allowlist(
+	"email",
+	"first_name",
+	"id",
+	"last_name",
+)
+`
+
+func TestGenerate(t *testing.T) {
+	s, err := readSchema(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := generate(s, "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expectedSource != string(src) {
+		t.Errorf("unexpected source:\n%s", src)
+	}
+}
+
+func TestReadSchema(t *testing.T) {
+	s, err := readSchema(strings.NewReader("column_name,data_type,TABLE_NAME\nid,integer,users\nid,integer,users\n"))
+	if err != nil || len(s) != 1 || len(s["users"]) != 2 {
+		t.Fail()
+	}
+
+	for _, csv := range []string{
+		"",
+		"table_name\nusers\n",
+		"table_name,column_name\n",
+		"table_name,column_name\nusers,\n",
+		"table_name,column_name\nusers,id,extra\n",
+	} {
+		if _, err := readSchema(strings.NewReader(csv)); err == nil {
+			t.Errorf("expected error for %q", csv)
+		}
+	}
+}
+
+func TestGenerateNameCollision(t *testing.T) {
+	_, err := generate(schema{"user_accounts": {"id"}, "UserAccounts": {"id"}}, "schema")
+	if err == nil {
+		t.Fail()
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	for table, expected := range map[string]string{
+		"users":         "Users",
+		"user_accounts": "UserAccounts",
+		"Orders":        "Orders",
+		"2fa_codes":     "T2faCodes",
+		"order-items":   "OrderItems",
+		"_":             "T",
+	} {
+		if expected != exportedName(table) {
+			t.Errorf("exportedName(%q) = %q, expected %q", table, exportedName(table), expected)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Command hotcoalgen generates hotcoal allowlists from a database schema,
+// so your allowlists stay in sync with your migrations.
+//
+// It reads the columns of your tables from a CSV export of information_schema.columns,
+// which has a header row and the table_name and column_name columns, e.g. for PostgreSQL:
+//
+//	psql -c "\copy (SELECT table_name, column_name FROM information_schema.columns WHERE table_schema = 'public') TO 'columns.csv' CSV HEADER"
+//
+// Then it generates a Go file with an allowlist of the tables,
+// and an allowlist of the columns of each table:
+//
+//	hotcoalgen -input columns.csv -output schema/allowlists.go -package schema
+//
+// hotcoalgen doesn't connect to the database itself,
+// so it doesn't depend on any database driver.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+	input := flag.String("input", "", "CSV export of information_schema.columns, with table_name and column_name columns")
+	output := flag.String("output", "", "path of the generated Go file, the standard output if empty")
+	pkg := flag.String("package", "schema", "package name of the generated Go file")
+
+	flag.Parse()
+
+	if *input == "" {
+		fmt.Fprintln(os.Stderr, "hotcoalgen: -input is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*input, *output, *pkg); err != nil {
+		fmt.Fprintf(os.Stderr, "hotcoalgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(input, output, pkg string) error {
+	f, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	schema, err := readSchema(f)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(schema, pkg)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+
+	return ioutil.WriteFile(output, src, 0644)
+}
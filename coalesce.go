@@ -0,0 +1,35 @@
+package hotcoal
+
+// Coalesce returns the first non-empty hotcoalString of values,
+// or an empty hotcoalString if all of them are empty.
+// It's useful for providing a default for an optional part of a query.
+func Coalesce(values ...hotcoalString) hotcoalString {
+	for _, el := range values {
+		if el != "" {
+			return el
+		}
+	}
+
+	return ""
+}
+
+// IfEmpty returns fallback if s is empty, otherwise it returns s.
+// You can chain method calls.
+func (s hotcoalString) IfEmpty(fallback hotcoalString) hotcoalString {
+	if s == "" {
+		return fallback
+	}
+
+	return s
+}
+
+// IfNotEmpty returns then if s is not empty, otherwise it returns an empty hotcoalString,
+// e.g. conditions.IfNotEmpty(" WHERE ") emits the WHERE keyword only if there are conditions.
+// You can chain method calls.
+func (s hotcoalString) IfNotEmpty(then hotcoalString) hotcoalString {
+	if s == "" {
+		return ""
+	}
+
+	return then
+}
@@ -0,0 +1,37 @@
+package hotcoal
+
+import "testing"
+
+func TestCoalesce(t *testing.T) {
+	if "" != Coalesce() || "" != Coalesce("", "") {
+		t.Fail()
+	}
+
+	if "id DESC" != Coalesce("", "id DESC", "name").String() || "name" != Coalesce("name", "", "id").String() {
+		t.Fail()
+	}
+}
+
+func TestIfEmpty(t *testing.T) {
+	if "id" != W("").IfEmpty("id").String() || "name" != W("name").IfEmpty("id").String() {
+		t.Fail()
+	}
+}
+
+func TestIfNotEmpty(t *testing.T) {
+	if "" != W("").IfNotEmpty(" WHERE ") || " WHERE " != W("a = ?").IfNotEmpty(" WHERE ").String() {
+		t.Fail()
+	}
+
+	for expected, conditions := range map[string]hotcoalString{
+		"SELECT * FROM users":               And(),
+		"SELECT * FROM users WHERE (a = ?)": And("a = ?"),
+	} {
+		var b Builder
+		b.Write("SELECT * FROM users").Write(conditions.IfNotEmpty(" WHERE ")).Write(conditions)
+
+		if expected != b.String() {
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package hotcoal
+
+// StripComments returns s with the -- line comments and /* */ block comments removed.
+// A block comment is replaced by a space, so the tokens around it don't merge,
+// e.g. "SELECT/**/1" becomes "SELECT 1". The newline after a line comment is kept.
+//
+// Comment markers inside string literals, quoted identifiers and PostgreSQL dollar-quoted
+// strings are not comments, so they are kept, e.g. in 'a -- b'.
+// Backslash escapes in string literals are not supported, please double the quotes instead.
+// Block comments don't nest.
+//
+// You can chain method calls.
+func (s hotcoalString) StripComments() hotcoalString {
+	var b Builder
+	b.Grow(len(s))
+
+	for _, segment := range splitSQL(string(s)) {
+		switch segment.kind {
+		case sqlLineComment:
+		case sqlBlockComment:
+			b.Write(" ")
+		default:
+			b.Write(hotcoalString(segment.text))
+		}
+	}
+
+	return b.HotcoalString()
+}
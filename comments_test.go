@@ -0,0 +1,34 @@
+package hotcoal
+
+import "testing"
+
+func TestStripComments(t *testing.T) {
+	for sql, expected := range map[hotcoalString]string{
+		"":                                     "",
+		"SELECT 1":                             "SELECT 1",
+		"SELECT 1 -- one":                      "SELECT 1 ",
+		"SELECT 1 -- one\nFROM t":              "SELECT 1 \nFROM t",
+		"SELECT 1 --\n--\nFROM t":              "SELECT 1 \n\nFROM t",
+		"SELECT /* one */ 1":                   "SELECT   1",
+		"SELECT/**/1":                          "SELECT 1",
+		"SELECT /* a\nb */ 1 /* c */":          "SELECT   1  ",
+		"SELECT /* a /* b */ 1 */":             "SELECT   1 */",
+		"SELECT 1 /* unterminated":             "SELECT 1  ",
+		"SELECT 1 - -1":                        "SELECT 1 - -1",
+		"SELECT 2 */ 1":                        "SELECT 2 */ 1",
+		"SELECT '--not a comment' -- comment":  "SELECT '--not a comment' ",
+		"SELECT '/* not */' /* comment */":     "SELECT '/* not */'  ",
+		"SELECT 'it''s -- ok' -- comment":      "SELECT 'it''s -- ok' ",
+		`SELECT "--col" FROM t -- comment`:     `SELECT "--col" FROM t `,
+		"SELECT `/*col*/` FROM t /**/":         "SELECT `/*col*/` FROM t  ",
+		"SELECT $$ -- $$ -- comment":           "SELECT $$ -- $$ ",
+		"SELECT $tag$ /* $tag$ /* comment */":  "SELECT $tag$ /* $tag$  ",
+		"SELECT '-- unterminated":              "SELECT '-- unterminated",
+		"SELECT 1 -- ' quote in comment\n, 2":  "SELECT 1 \n, 2",
+		"SELECT 1 /* ' quote in comment */, 2": "SELECT 1  , 2",
+	} {
+		if actual := sql.StripComments().String(); expected != actual {
+			t.Errorf("%q: expected %q, actual %q", sql, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package hotcoal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// And joins the conditions with AND and wraps them in parentheses,
+// e.g. And("a = ?", "b = ?") returns "(a = ? AND b = ?)".
+// Empty conditions are skipped, so you can nest And and Or.
+// If all the conditions are empty, it returns an empty hotcoalString,
+// so you can omit the WHERE clause.
+func And(conditions ...hotcoalString) hotcoalString {
+	return joinConditions(conditions, " AND ")
+}
+
+// Or joins the conditions with OR and wraps them in parentheses,
+// e.g. Or("a = ?", "b = ?") returns "(a = ? OR b = ?)".
+// Empty conditions are skipped, so you can nest And and Or.
+// If all the conditions are empty, it returns an empty hotcoalString,
+// so you can omit the WHERE clause.
+func Or(conditions ...hotcoalString) hotcoalString {
+	return joinConditions(conditions, " OR ")
+}
+
+func joinConditions(conditions Slice, sep hotcoalString) hotcoalString {
+	nonEmpty := make(Slice, 0, len(conditions))
+	for _, el := range conditions {
+		if el != "" {
+			nonEmpty = append(nonEmpty, el)
+		}
+	}
+
+	if len(nonEmpty) == 0 {
+		return ""
+	}
+
+	return "(" + nonEmpty.Join(sep) + ")"
+}
+
+// Conditions collects the conditions of a WHERE clause, e.g. the filters requested by the client,
+// and joins them using And or Or. The zero value has no conditions, ready to use:
+//
+//	var where hotcoal.Conditions
+//	if name != "" {
+//		where.Add(hotcoal.W("first_name = ?"))
+//	}
+//	cond := where.And()
+//	sql := hotcoal.W("SELECT * FROM users") + cond.IfNotEmpty(hotcoal.W(" WHERE ")) + cond
+//
+// Unlike the And and Or functions, the And and Or methods take no arguments, please use Add.
+type Conditions struct {
+	items Slice
+}
+
+// Add adds the conditions, empty conditions are skipped.
+// It returns c, you can chain method calls.
+func (c *Conditions) Add(conditions ...hotcoalString) *Conditions {
+	for _, el := range conditions {
+		if el != "" {
+			c.items = append(c.items, el)
+		}
+	}
+
+	return c
+}
+
+// IsEmpty reports whether there are no conditions, so And and Or return an empty hotcoalString.
+func (c *Conditions) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// And joins the conditions with AND and wraps them in parentheses, see the And function.
+// If there are no conditions, it returns an empty hotcoalString.
+func (c *Conditions) And() hotcoalString {
+	return joinConditions(c.items, " AND ")
+}
+
+// Or joins the conditions with OR and wraps them in parentheses, see the Or function.
+// If there are no conditions, it returns an empty hotcoalString.
+func (c *Conditions) Or() hotcoalString {
+	return joinConditions(c.items, " OR ")
+}
+
+// InClause returns the condition "column IN (?, ?, ?)" with a placeholder for each value,
+// and the values as args.
+// If there are no values, it returns "column IN (NULL)", which is valid SQL and never true.
+func InClause(column hotcoalString, values []interface{}) (hotcoalString, []interface{}) {
+	if len(values) == 0 {
+		return column + " IN (NULL)", nil
+	}
+
+	args := make([]interface{}, len(values))
+	copy(args, values)
+
+	return column + " IN (" + Placeholders(len(values)) + ")", args
+}
+
+// TupleIn returns the row-value condition "(column1, column2) IN ((?, ?), (?, ?))",
+// with a tuple of placeholders for each of the rows, e.g. for composite keys.
+// Please pass the arguments row by row.
+// If there are no columns, or rows is less than 1, it returns an error.
+func TupleIn(columns Slice, rows int) (hotcoalString, error) {
+	if len(columns) == 0 {
+		return "", errors.New("Hotcoal error - TupleIn received no columns")
+	}
+
+	if rows < 1 {
+		return "", fmt.Errorf("Hotcoal error - TupleIn received %d rows", rows)
+	}
+
+	var b Builder
+
+	b.WriteList("(", ", ", ")", columns).Write(" IN (").Write(PlaceholderGroups(rows, len(columns))).Write(")")
+
+	return b.HotcoalString(), nil
+}
+
+// likeEscaper escapes the wildcards of LIKE, and the escape character itself.
+// The [ is a wildcard of SQLServer.
+var likeEscaper = strings.NewReplacer(
+	"!", "!!",
+	"%", "!%",
+	"_", "!_",
+	"[", "![",
+)
+
+// EscapeLike escapes the LIKE wildcards % and _, the SQLServer wildcard [,
+// and the escape character ! in a string variable, so it matches literally
+// in a LIKE pattern declared with ESCAPE '!'.
+// The result is a plain string, please pass it as an argument, never concatenate it into SQL.
+//
+//hotcoal:boundary
+func EscapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// LikeContains returns the condition "column LIKE ? ESCAPE '!'" and the argument,
+// which matches the values containing userInput.
+// The wildcards in userInput are escaped, so e.g. "100%" doesn't match everything starting with "100".
+func LikeContains(column hotcoalString, userInput string) (hotcoalString, []interface{}) {
+	return like(column, "%"+EscapeLike(userInput)+"%")
+}
+
+// LikePrefix returns the condition "column LIKE ? ESCAPE '!'" and the argument,
+// which matches the values starting with userInput.
+// The wildcards in userInput are escaped.
+func LikePrefix(column hotcoalString, userInput string) (hotcoalString, []interface{}) {
+	return like(column, EscapeLike(userInput)+"%")
+}
+
+// LikeSuffix returns the condition "column LIKE ? ESCAPE '!'" and the argument,
+// which matches the values ending with userInput.
+// The wildcards in userInput are escaped.
+func LikeSuffix(column hotcoalString, userInput string) (hotcoalString, []interface{}) {
+	return like(column, "%"+EscapeLike(userInput))
+}
+
+func like(column hotcoalString, pattern string) (hotcoalString, []interface{}) {
+	return column + " LIKE ? ESCAPE '!'", []interface{}{pattern}
+}
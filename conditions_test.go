@@ -0,0 +1,175 @@
+package hotcoal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnd(t *testing.T) {
+	if "(a = ? AND b = ?)" != And("a = ?", "b = ?").String() {
+		t.Fail()
+	}
+
+	if "(a = ?)" != And("a = ?").String() {
+		t.Fail()
+	}
+
+	if "(a = ? AND b = ?)" != And("", "a = ?", "", "b = ?").String() {
+		t.Fail()
+	}
+
+	if "" != And().String() || "" != And("", "").String() {
+		t.Fail()
+	}
+}
+
+func TestOr(t *testing.T) {
+	if "(a = ? OR b = ?)" != Or("a = ?", "b = ?").String() {
+		t.Fail()
+	}
+
+	if "(a = ? OR b = ?)" != Or("a = ?", "", "b = ?").String() {
+		t.Fail()
+	}
+
+	if "" != Or().String() || "" != Or("").String() {
+		t.Fail()
+	}
+}
+
+func TestConditionsNested(t *testing.T) {
+	where := And(
+		"deleted_at IS NULL",
+		Or("first_name = ?", "nickname = ?"),
+		Or(),
+	)
+
+	if "(deleted_at IS NULL AND (first_name = ? OR nickname = ?))" != where.String() {
+		t.Fail()
+	}
+
+	if "" != And(Or(), And("")).String() {
+		t.Fail()
+	}
+}
+
+func TestConditions(t *testing.T) {
+	var where Conditions
+
+	if !where.IsEmpty() || "" != where.And().String() || "" != where.Or().String() {
+		t.Fail()
+	}
+
+	where.Add("deleted_at IS NULL").Add("", Or("first_name = ?", "nickname = ?"), Or())
+
+	if where.IsEmpty() {
+		t.Fail()
+	}
+
+	if "(deleted_at IS NULL AND (first_name = ? OR nickname = ?))" != where.And().String() {
+		t.Fail()
+	}
+
+	if "(deleted_at IS NULL OR (first_name = ? OR nickname = ?))" != where.Or().String() {
+		t.Fail()
+	}
+}
+
+func TestConditionsWhere(t *testing.T) {
+	query := func(where *Conditions) hotcoalString {
+		cond := where.And()
+
+		return W("SELECT * FROM users") + cond.IfNotEmpty(W(" WHERE ")) + cond
+	}
+
+	if "SELECT * FROM users" != query(&Conditions{}).String() {
+		t.Fail()
+	}
+
+	if "SELECT * FROM users WHERE (first_name = ?)" != query((&Conditions{}).Add(W("first_name = ?"))).String() {
+		t.Fail()
+	}
+}
+
+func TestInClause(t *testing.T) {
+	hs, args := InClause("id", []interface{}{1, 2, 3})
+	if "id IN (?, ?, ?)" != hs.String() || !reflect.DeepEqual([]interface{}{1, 2, 3}, args) {
+		t.Fail()
+	}
+
+	hs, args = InClause("id", nil)
+	if "id IN (NULL)" != hs.String() || args != nil {
+		t.Fail()
+	}
+
+	hs, _ = InClause("status", []interface{}{"active"})
+	if "(deleted_at IS NULL AND status IN (?))" != And("deleted_at IS NULL", hs).String() {
+		t.Fail()
+	}
+}
+
+func TestTupleIn(t *testing.T) {
+	hs, err := TupleIn(Slice{"tenant_id", "user_id"}, 3)
+	if "(tenant_id, user_id) IN ((?, ?), (?, ?), (?, ?))" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = TupleIn(Slice{"id"}, 1)
+	if "(id) IN ((?))" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestTupleInError(t *testing.T) {
+	hs, err := TupleIn(nil, 3)
+	if "" != hs.String() || err == nil {
+		t.Fail()
+	}
+
+	for _, rows := range []int{0, -1} {
+		hs, err = TupleIn(Slice{"tenant_id", "user_id"}, rows)
+		if "" != hs.String() || err == nil {
+			t.Fail()
+		}
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	for value, expected := range map[string]string{
+		"":           "",
+		"john":       "john",
+		"100%":       "100!%",
+		"first_name": "first!_name",
+		"[a-z]":      "![a-z]",
+		"wow!":       "wow!!",
+		"!%_[":       "!!!%!_![",
+		`back\slash`: `back\slash`,
+		"it's":       "it's",
+	} {
+		if expected != EscapeLike(value) {
+			t.Error(value, EscapeLike(value))
+		}
+	}
+}
+
+func TestLike(t *testing.T) {
+	hs, args := LikeContains("name", "50%_off!")
+	if "name LIKE ? ESCAPE '!'" != hs.String() || !reflect.DeepEqual([]interface{}{"%50!%!_off!!%"}, args) {
+		t.Fail()
+	}
+
+	hs, args = LikePrefix("name", "a_b")
+	if "name LIKE ? ESCAPE '!'" != hs.String() || !reflect.DeepEqual([]interface{}{"a!_b%"}, args) {
+		t.Fail()
+	}
+
+	hs, args = LikeSuffix("name", "a%b")
+	if "name LIKE ? ESCAPE '!'" != hs.String() || !reflect.DeepEqual([]interface{}{"%a!%b"}, args) {
+		t.Fail()
+	}
+
+	hs, args = LikeContains("name", "")
+	if "name LIKE ? ESCAPE '!'" != hs.String() || !reflect.DeepEqual([]interface{}{"%%"}, args) {
+		t.Fail()
+	}
+}
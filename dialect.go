@@ -0,0 +1,172 @@
+package hotcoal
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Dialect is an SQL dialect, it's used to handcraft SQL,
+// which differs between databases, e.g. quoting of identifiers.
+type Dialect int
+
+// The supported SQL dialects
+const (
+	Postgres Dialect = iota + 1
+	MySQL
+	SQLServer
+	SQLite
+)
+
+// String returns the name of the dialect
+func (d Dialect) String() string {
+	switch d {
+	case Postgres:
+		return "Postgres"
+	case MySQL:
+		return "MySQL"
+	case SQLServer:
+		return "SQLServer"
+	case SQLite:
+		return "SQLite"
+	default:
+		return fmt.Sprintf("Dialect(%d)", int(d))
+	}
+}
+
+// identifierQuoteChars are rejected in quoted identifiers of every dialect,
+// since they may close the quotes, or escape the closing quote
+const identifierQuoteChars = "\"`[]'\\\x00"
+
+// identifierRules are the quoting and the length limit of identifiers of a dialect
+type identifierRules struct {
+	open, close hotcoalString
+	maxBytes    int // 0 means no limit
+	maxRunes    int // 0 means no limit
+}
+
+var dialectIdentifierRules = map[Dialect]identifierRules{
+	Postgres:  {open: `"`, close: `"`, maxBytes: MaxIdentifierLength},
+	MySQL:     {open: "`", close: "`", maxRunes: 64},
+	SQLServer: {open: "[", close: "]", maxRunes: 128},
+	SQLite:    {open: `"`, close: `"`},
+}
+
+// QuoteIdentifier validates a string variable as an SQL identifier, such as a column name or a table name,
+// and returns it as a hotcoalString, quoted for the dialect:
+// "first_name" for Postgres and SQLite, `first_name` for MySQL, [first_name] for SQLServer.
+//
+// The value may contain any characters, except the quote and escape characters of all the dialects:
+// " ` [ ] ' \ and the NUL character. Rejecting them is what makes the quoted identifier safe.
+// It must not be empty or longer than the limit of the dialect:
+// 63 bytes for Postgres, 64 characters for MySQL, 128 characters for SQLServer.
+// Otherwise it returns an error, which wraps ErrInvalidIdentifier.
+//
+//hotcoal:boundary
+func (d Dialect) QuoteIdentifier(value string) (hotcoalString, error) {
+	rules, ok := dialectIdentifierRules[d]
+	if !ok {
+		return "", fmt.Errorf("Hotcoal error - unknown dialect %v", d)
+	}
+
+	if value == "" {
+		return "", invalidIdentifierError(value, "it is empty")
+	}
+
+	if rules.maxBytes > 0 && len(value) > rules.maxBytes {
+		return "", invalidIdentifierError(value, fmt.Sprintf("it is longer than %d bytes", rules.maxBytes))
+	}
+
+	if rules.maxRunes > 0 && utf8.RuneCountInString(value) > rules.maxRunes {
+		return "", invalidIdentifierError(value, fmt.Sprintf("it is longer than %d characters", rules.maxRunes))
+	}
+
+	if i := strings.IndexAny(value, identifierQuoteChars); i != -1 {
+		return "", invalidIdentifierError(value, fmt.Sprintf("it contains %q at position %d", value[i], i))
+	}
+
+	return rules.open + hotcoalString(value) + rules.close, nil
+}
+
+// RewritePlaceholders rewrites the question mark placeholders of the SQL to the placeholders of the dialect:
+// $1, $2, ... for Postgres and @p1, @p2, ... for SQLServer.
+// MySQL and SQLite use question marks, so it returns the SQL unchanged.
+// It panics for an unknown dialect.
+//
+// Question marks inside string literals, quoted identifiers and comments are not rewritten.
+// Backslash escapes in string literals are not supported, please double the quotes instead.
+// Please note that question marks used as PostgreSQL operators, e.g. the jsonb ? operator,
+// are rewritten, so please don't use them in the SQL.
+//
+// You can chain method calls.
+func (d Dialect) RewritePlaceholders(sql hotcoalString) hotcoalString {
+	var prefix hotcoalString
+
+	switch d {
+	case Postgres:
+		prefix = "$"
+	case SQLServer:
+		prefix = "@p"
+	case MySQL, SQLite:
+		return sql
+	default:
+		panic(fmt.Sprintf("Hotcoal error - unknown dialect %v", d))
+	}
+
+	var b Builder
+	b.Grow(len(sql))
+
+	n := 0
+
+	for _, segment := range splitSQL(string(sql)) {
+		if segment.kind != sqlCode {
+			b.Write(hotcoalString(segment.text))
+			continue
+		}
+
+		for _, part := range strings.SplitAfter(segment.text, "?") {
+			if !strings.HasSuffix(part, "?") {
+				b.Write(hotcoalString(part))
+				continue
+			}
+
+			n++
+			b.Write(hotcoalString(part[:len(part)-1])).Write(prefix).WriteInt(n)
+		}
+	}
+
+	return b.HotcoalString()
+}
+
+// Returning validates the columns against the allowlist and returns the clause,
+// which returns the columns of the inserted or updated rows, with a leading space:
+// " RETURNING id, created_at" for Postgres and SQLite,
+// " OUTPUT INSERTED.id, INSERTED.created_at" for SQLServer.
+// Please note that the OUTPUT clause of SQLServer goes before VALUES or WHERE, not at the end.
+// MySQL doesn't support returning columns, so it returns an error.
+// If there are no columns, it returns an empty hotcoalString.
+// If any column is not in the allowlist, it returns the error of the first one.
+func (d Dialect) Returning(allowlist allowlistT, cols ...string) (hotcoalString, error) {
+	switch d {
+	case Postgres, SQLite:
+		return Returning(allowlist, cols...)
+	case SQLServer:
+	case MySQL:
+		return "", fmt.Errorf("Hotcoal error - dialect %v doesn't support returning columns", d)
+	default:
+		return "", fmt.Errorf("Hotcoal error - unknown dialect %v", d)
+	}
+
+	if len(cols) == 0 {
+		return "", nil
+	}
+
+	columns, err := allowlist.ValidateAll(cols)
+	if err != nil {
+		return "", err
+	}
+
+	return " OUTPUT " + columns.Map(func(el hotcoalString) hotcoalString {
+		return "INSERTED." + el
+	}).Join(", "), nil
+}
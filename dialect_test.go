@@ -0,0 +1,166 @@
+package hotcoal
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDialectString(t *testing.T) {
+	if "Postgres" != Postgres.String() || "MySQL" != MySQL.String() ||
+		"SQLServer" != SQLServer.String() || "SQLite" != SQLite.String() ||
+		"Dialect(0)" != Dialect(0).String() {
+		t.Fail()
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	for dialect, expected := range map[Dialect]string{
+		Postgres:  `"first name"`,
+		MySQL:     "`first name`",
+		SQLServer: "[first name]",
+		SQLite:    `"first name"`,
+	} {
+		hs, err := dialect.QuoteIdentifier("first name")
+		if expected != hs.String() || err != nil {
+			t.Errorf("%v: %q, %v", dialect, hs, err)
+		}
+	}
+
+	hs, err := MySQL.QuoteIdentifier("prénom")
+	if "`prénom`" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestQuoteIdentifierError(t *testing.T) {
+	for _, dialect := range []Dialect{Postgres, MySQL, SQLServer, SQLite} {
+		for _, el := range []string{
+			"",
+			`a"; DROP TABLE users; --`,
+			"a`; DROP TABLE users; --",
+			"a]; DROP TABLE users; --",
+			"[a",
+			"a'",
+			`a\`,
+			"a\x00",
+		} {
+			hs, err := dialect.QuoteIdentifier(el)
+			if "" != hs.String() || !errors.Is(err, ErrInvalidIdentifier) {
+				t.Errorf("%v: %q should not be valid", dialect, el)
+			}
+		}
+	}
+
+	_, err := Dialect(0).QuoteIdentifier("first_name")
+	if err == nil || errors.Is(err, ErrInvalidIdentifier) {
+		t.Fail()
+	}
+}
+
+func TestQuoteIdentifierLength(t *testing.T) {
+	for dialect, maxLength := range map[Dialect]int{
+		Postgres:  63,
+		MySQL:     64,
+		SQLServer: 128,
+	} {
+		if _, err := dialect.QuoteIdentifier(strings.Repeat("a", maxLength)); err != nil {
+			t.Errorf("%v: %v", dialect, err)
+		}
+
+		if _, err := dialect.QuoteIdentifier(strings.Repeat("a", maxLength+1)); !errors.Is(err, ErrInvalidIdentifier) {
+			t.Errorf("%v: expected an error", dialect)
+		}
+	}
+
+	// Postgres limits bytes, MySQL limits characters
+	if _, err := Postgres.QuoteIdentifier(strings.Repeat("é", 32)); err == nil {
+		t.Fail()
+	}
+
+	if _, err := MySQL.QuoteIdentifier(strings.Repeat("é", 64)); err != nil {
+		t.Fail()
+	}
+
+	if _, err := SQLite.QuoteIdentifier(strings.Repeat("a", 1000)); err != nil {
+		t.Fail()
+	}
+}
+
+func TestRewritePlaceholders(t *testing.T) {
+	sql := W("SELECT * FROM users WHERE first_name = ? AND last_name IN (?, ?)")
+
+	if "SELECT * FROM users WHERE first_name = $1 AND last_name IN ($2, $3)" != Postgres.RewritePlaceholders(sql).String() {
+		t.Fail()
+	}
+
+	if "SELECT * FROM users WHERE first_name = @p1 AND last_name IN (@p2, @p3)" != SQLServer.RewritePlaceholders(sql).String() {
+		t.Fail()
+	}
+
+	if sql != MySQL.RewritePlaceholders(sql) || sql != SQLite.RewritePlaceholders(sql) {
+		t.Fail()
+	}
+
+	if "" != Postgres.RewritePlaceholders("").String() || "?" != MySQL.RewritePlaceholders("?").String() {
+		t.Fail()
+	}
+
+	if "$1$2" != Postgres.RewritePlaceholders("??").String() {
+		t.Fail()
+	}
+}
+
+func TestRewritePlaceholdersSkipsLiteralsAndComments(t *testing.T) {
+	sql := W(`SELECT 'why?', 'it''s ?', "col?" -- is it?
+FROM users /* really? */ WHERE a = ? AND b = $$?$$ AND c = ?`)
+
+	expected := `SELECT 'why?', 'it''s ?', "col?" -- is it?
+FROM users /* really? */ WHERE a = $1 AND b = $$?$$ AND c = $2`
+
+	if expected != Postgres.RewritePlaceholders(sql).String() {
+		t.Error(Postgres.RewritePlaceholders(sql))
+	}
+}
+
+func TestRewritePlaceholdersUnknownDialect(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fail()
+		}
+	}()
+
+	Dialect(0).RewritePlaceholders("?")
+}
+
+func TestDialectReturning(t *testing.T) {
+	allowlist := Allowlist("id", "created_at")
+
+	for dialect, expected := range map[Dialect]string{
+		Postgres:  " RETURNING id, created_at",
+		SQLite:    " RETURNING id, created_at",
+		SQLServer: " OUTPUT INSERTED.id, INSERTED.created_at",
+	} {
+		hs, err := dialect.Returning(allowlist, "id", "created_at")
+		if expected != hs.String() || err != nil {
+			t.Error(dialect, hs, err)
+		}
+
+		hs, err = dialect.Returning(allowlist)
+		if "" != hs.String() || err != nil {
+			t.Error(dialect, hs, err)
+		}
+
+		hs, err = dialect.Returning(allowlist, "password")
+		if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+			t.Error(dialect, hs, err)
+		}
+	}
+
+	for _, dialect := range []Dialect{MySQL, Dialect(0)} {
+		hs, err := dialect.Returning(allowlist, "id")
+		if "" != hs.String() || err == nil {
+			t.Error(dialect, hs, err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package hotcoal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotInAllowlist is returned when a value is not in the allowlist.
+// The returned error is a *ValidationError, you can check for it using errors.Is
+var ErrNotInAllowlist = errors.New("Hotcoal validation error - value is not in allowlist")
+
+// ValidationError is returned when a value is not in the allowlist.
+// You can get it from the returned error using errors.As
+type ValidationError struct {
+	// Value is the value, which failed the validation
+	Value string
+
+	// items is only set by verbose allowlists, see AllowlistVerbose, they are sorted
+	items []string
+}
+
+// Error returns the error message.
+// It doesn't list the allowlist items, unless the allowlist was created using AllowlistVerbose.
+// The items are sorted, so the message is the same across runs.
+func (e *ValidationError) Error() string {
+	if e.items == nil {
+		return fmt.Sprintf("Hotcoal validation error - value %#v is not in allowlist", e.Value)
+	}
+
+	return fmt.Sprintf("Hotcoal validation error - value %#v is not in allowlist %#v", e.Value, e.items)
+}
+
+// Is reports whether the target is ErrNotInAllowlist, so you can use errors.Is
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrNotInAllowlist
+}
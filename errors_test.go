@@ -0,0 +1,96 @@
+package hotcoal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestValidationError(t *testing.T) {
+	_, err := Allowlist("foo", "bar").Validate("baz")
+
+	if !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) || "baz" != validationError.Value {
+		t.Fail()
+	}
+
+	wrapped := fmt.Errorf("cannot query users: %w", err)
+
+	if !errors.Is(wrapped, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	validationError = nil
+	if !errors.As(wrapped, &validationError) || "baz" != validationError.Value {
+		t.Fail()
+	}
+
+	if errors.Is(errors.New("foo"), ErrNotInAllowlist) {
+		t.Fail()
+	}
+}
+
+func TestValidationErrorShorthands(t *testing.T) {
+	allowlist := Allowlist("foo")
+
+	_, err := allowlist.V("baz")
+	if !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			if !ok || !errors.Is(err, ErrNotInAllowlist) {
+				t.Fail()
+			}
+		}()
+
+		allowlist.MV("baz")
+	}()
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	_, err := Allowlist("foo", "bar").Validate("baz")
+	if `Hotcoal validation error - value "baz" is not in allowlist` != err.Error() {
+		t.Fail()
+	}
+
+	_, err = AllowlistVerbose("foo", "bar").Validate("baz")
+	if `Hotcoal validation error - value "baz" is not in allowlist []string{"bar", "foo"}` != err.Error() {
+		t.Fail()
+	}
+
+	if !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	_, err = AllowlistVerbose("foo").With("bar").Validate("baz")
+	if `Hotcoal validation error - value "baz" is not in allowlist []string{"bar", "foo"}` != err.Error() {
+		t.Fail()
+	}
+
+	_, err = Union(AllowlistVerbose("foo"), Allowlist("bar")).Validate("baz")
+	if `Hotcoal validation error - value "baz" is not in allowlist` != err.Error() {
+		t.Fail()
+	}
+}
+
+func TestValidationErrorDeterministic(t *testing.T) {
+	items := Slice{"id", "first_name", "last_name", "email", "created_at", "updated_at", "deleted_at", "age"}
+
+	expected := `Hotcoal validation error - value "password" is not in allowlist ` +
+		`[]string{"age", "created_at", "deleted_at", "email", "first_name", "id", "last_name", "updated_at"}`
+
+	// the items are stored in a map, whose iteration order differs between constructions
+	for i := 0; i < 100; i++ {
+		_, err := AllowlistVerbose(items[0], items[1:]...).Validate("password")
+		if err == nil || expected != err.Error() {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package hotcoal
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Format implements fmt.Formatter https://pkg.go.dev/fmt#Formatter
+// so that a hotcoalString is formatted exactly like its underlying string,
+// e.g. %s and %v print the value, and %q quotes it.
+// Flags, width and precision are supported.
+func (s hotcoalString) Format(f fmt.State, verb rune) {
+	format := []byte{'%'}
+
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			format = append(format, byte(flag))
+		}
+	}
+
+	if width, ok := f.Width(); ok {
+		format = strconv.AppendInt(format, int64(width), 10)
+	}
+
+	if precision, ok := f.Precision(); ok {
+		format = append(format, '.')
+		format = strconv.AppendInt(format, int64(precision), 10)
+	}
+
+	format = append(format, string(verb)...)
+
+	fmt.Fprintf(f, string(format), string(s))
+}
@@ -0,0 +1,37 @@
+package hotcoal
+
+import (
+	"fmt"
+	"testing"
+)
+
+var _ fmt.Formatter = hotcoalString("")
+
+func TestFormat(t *testing.T) {
+	hs := W(`SELECT "name" FROM users`)
+
+	for format, expected := range map[string]string{
+		"%s":      `SELECT "name" FROM users`,
+		"%v":      `SELECT "name" FROM users`,
+		"%q":      `"SELECT \"name\" FROM users"`,
+		"%#q":     "`SELECT \"name\" FROM users`",
+		"%#v":     `"SELECT \"name\" FROM users"`,
+		"%.6s":    `SELECT`,
+		"%-8.6s|": `SELECT  |`,
+		"%8.6s":   `  SELECT`,
+	} {
+		if actual := fmt.Sprintf(format, hs); expected != actual {
+			t.Errorf("%s: expected %s, actual %s", format, expected, actual)
+		}
+	}
+}
+
+func TestFormatSlice(t *testing.T) {
+	if `[a b]` != fmt.Sprintf("%v", Slice{"a", "b"}) {
+		t.Fail()
+	}
+
+	if `["a" "b"]` != fmt.Sprintf("%q", Slice{"a", "b"}) {
+		t.Fail()
+	}
+}
@@ -5,19 +5,59 @@ package hotcoal
 // protecting against SQL injection
 type hotcoalString string
 
-// Slice is an alias for a slice of hotcoalStrings.
-// Since hotcoalString is not exported, we export this alias,
+// Slice is a slice of hotcoalStrings.
+// Since hotcoalString is not exported, we export this type,
 // which allows you to create slices.
-type Slice = []hotcoalString
+type Slice []hotcoalString
+
+// Map is a map of strings to hotcoalStrings.
+// Since hotcoalString is not exported, we export this type,
+// which allows you to create maps.
+type Map map[string]hotcoalString
+
+// SafeString holds a hotcoalString, it's the extension point for libraries building on hotcoal:
+// since hotcoalString is not exported, accept a SafeString, and you can be sure the value was handcrafted
+// using hotcoal. Its field is unexported, so it can only be created using the Safe method of a hotcoalString,
+// e.g. query.Safe(), and the zero value is an empty hotcoalString.
+type SafeString struct {
+	s hotcoalString
+}
+
+// The Safe method wraps a hotcoalString in a SafeString, e.g. to pass it to a library building on hotcoal.
+func (s hotcoalString) Safe() SafeString {
+	return SafeString{s: s}
+}
+
+// The HotcoalString method returns the hotcoalString held by the SafeString,
+// so you can continue handcrafting SQL.
+func (s SafeString) HotcoalString() hotcoalString {
+	return s.s
+}
+
+// The String method converts the SafeString to a plain string, see hotcoalString.String
+//
+//hotcoal:boundary
+func (s SafeString) String() string {
+	return string(s.s)
+}
 
 // The String method converts a hotcoalString to a plain string.
 // Please do all your SQL handcrafting using hotcoalStrings,
 // and convert the result to a plain string only when you pass it to the
 // SQL library.
+//
+//hotcoal:boundary
 func (s hotcoalString) String() string {
 	return string(s)
 }
 
+// The Bytes method returns the bytes of a hotcoalString, e.g. for writing it to an io.Writer.
+// There's no way back from bytes to a hotcoalString, since the bytes may come from user input.
+// The returned slice is a copy, modifying it doesn't affect the hotcoalString.
+func (s hotcoalString) Bytes() []byte {
+	return []byte(s)
+}
+
 // The Wrap function converts an untyped string constant to a hotcoalString.
 // You can only use it with an untyped string constant, not with a string variable.
 // For the latter, please use an Allowlist to validate the variable and guard
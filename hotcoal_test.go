@@ -11,3 +11,51 @@ func TestString(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestBytes(t *testing.T) {
+	hs := W("SELECT 1")
+
+	b := hs.Bytes()
+	if "SELECT 1" != string(b) {
+		t.Fail()
+	}
+
+	b[0] = 'X'
+	if "SELECT 1" != hs.String() {
+		t.Fail()
+	}
+}
+
+func TestSafeString(t *testing.T) {
+	safe := W("SELECT 1").Safe()
+
+	if "SELECT 1" != safe.String() {
+		t.Fail()
+	}
+
+	if "SELECT 1 LIMIT 1" != (safe.HotcoalString() + " LIMIT 1").String() {
+		t.Fail()
+	}
+
+	var zero SafeString
+	if "" != zero.String() || "" != zero.HotcoalString().String() {
+		t.Fail()
+	}
+}
+
+// forgedSafeString embeds a SafeString and overrides its String method
+type forgedSafeString struct {
+	SafeString
+}
+
+func (s forgedSafeString) String() string {
+	return "1; DROP TABLE users"
+}
+
+func TestSafeStringEmbedding(t *testing.T) {
+	// a type embedding SafeString is not a SafeString, so it can't be passed as one
+	var value interface{} = forgedSafeString{}
+	if _, ok := value.(SafeString); ok {
+		t.Fail()
+	}
+}
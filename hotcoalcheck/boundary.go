@@ -0,0 +1,179 @@
+package hotcoalcheck
+
+import (
+	"go/ast"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// BoundaryDirective marks a trusted boundary function, when it's a line of its doc comment:
+//
+//	// Rebind rewrites the placeholders of the query.
+//	//
+//	//hotcoal:boundary
+//	func Rebind(query string) string {
+//
+// gofmt moves it to the end of the doc comment, along with other directives, such as //go:noinline.
+// A comment, which is not the doc comment of the function, e.g. it's separated by a blank line, doesn't mark it.
+//
+// A trusted boundary is a function, where a plain string legitimately crosses into or out of hotcoal:
+// it validates a plain string and returns a hotcoalString, e.g. Validate,
+// it returns hotcoal SQL as a plain string for the SQL library, e.g. String,
+// or it transforms such SQL without making it unsafe, e.g. rewriting its placeholders.
+//
+// The analyzers treat the result of a boundary function as hotcoal SQL, which isn't modified,
+// as long as its arguments aren't modified hotcoal SQL either.
+// You can mark the functions of your own package, the analyzers share the marks across packages as facts.
+const BoundaryDirective = "//hotcoal:boundary"
+
+// Boundaries is the registry of the trusted boundary functions, keyed by types.Func.FullName,
+// e.g. "(*github.com/motrboat/hotcoal.Builder).String", see BoundaryDirective.
+// It contains the functions of hotcoal, which are all marked using BoundaryDirective,
+// and the functions of common libraries, which can't be marked.
+var Boundaries = map[string]bool{
+	"(" + hotcoalPath + ".hotcoalString).String":             true,
+	"(" + hotcoalPath + ".SafeString).String":                true,
+	"(*" + hotcoalPath + ".Builder).String":                  true,
+	"(*" + hotcoalPath + ".Query).Build":                     true,
+	"(*" + hotcoalPath + ".QueryBuilder).Build":              true,
+	"(*" + hotcoalPath + ".NamedQueryBuilder).Build":         true,
+	"(*" + hotcoalPath + ".NamedQueryBuilder).BuildArgs":     true,
+	"(" + hotcoalPath + ".allowlistT).Validate":              true,
+	"(" + hotcoalPath + ".allowlistT).V":                     true,
+	"(" + hotcoalPath + ".allowlistT).MustValidate":          true,
+	"(" + hotcoalPath + ".allowlistT).MV":                    true,
+	"(" + hotcoalPath + ".allowlistT).ValidateAll":           true,
+	"(" + hotcoalPath + ".allowlistT).VA":                    true,
+	"(" + hotcoalPath + ".allowlistT).MustValidateAll":       true,
+	"(" + hotcoalPath + ".allowlistT).MVA":                   true,
+	"(" + hotcoalPath + ".allowlistT).ValidateAllCollecting": true,
+	"(" + hotcoalPath + ".allowlistT).ValidateOrDefault":     true,
+	"(" + hotcoalPath + ".allowlistT).ValidateAs":            true,
+	"(*" + hotcoalPath + ".MutableAllowlist).Validate":       true,
+	"(*" + hotcoalPath + ".MutableAllowlist).V":              true,
+	"(" + hotcoalPath + ".regexAllowlistT).Validate":         true,
+	"(" + hotcoalPath + ".regexAllowlistT).V":                true,
+	"(" + hotcoalPath + ".regexAllowlistT).MustValidate":     true,
+	"(" + hotcoalPath + ".regexAllowlistT).MV":               true,
+	hotcoalPath + ".ValidateIdentifier":                      true,
+	hotcoalPath + ".ValidateQuotedIdentifier":                true,
+	hotcoalPath + ".ValidateQualified":                       true,
+	hotcoalPath + ".ValidateInt":                             true,
+	hotcoalPath + ".ValidateIntRange":                        true,
+	"(" + hotcoalPath + ".Dialect).QuoteIdentifier":          true,
+	hotcoalPath + ".EscapeLike":                              true,
+	hotcoalPath + ".AllowlistFromJSON":                       true,
+
+	"github.com/jmoiron/sqlx.Rebind":         true,
+	"(*github.com/jmoiron/sqlx.DB).Rebind":   true,
+	"(*github.com/jmoiron/sqlx.Tx).Rebind":   true,
+	"(*github.com/jmoiron/sqlx.Conn).Rebind": true,
+}
+
+// boundaryList is a set of full function names, the value of the -boundaries flag of SQLAnalyzer
+type boundaryList map[string]bool
+
+func (l boundaryList) String() string {
+	names := make([]string, 0, len(l))
+	for name := range l {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return strings.Join(names, ",")
+}
+
+// Set replaces the list with the comma-separated names, an empty value clears it
+func (l boundaryList) Set(value string) error {
+	for name := range l {
+		delete(l, name)
+	}
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		l[name] = true
+	}
+
+	return nil
+}
+
+// extraBoundaries are the boundary functions given using the -boundaries flag,
+// e.g. the functions of your own packages, which can't be marked
+var extraBoundaries = boundaryList{}
+
+// boundaryFact marks a function of an analyzed package as a trusted boundary
+type boundaryFact struct{}
+
+func (*boundaryFact) AFact() {}
+
+func (*boundaryFact) String() string {
+	return "hotcoalBoundary"
+}
+
+// exportBoundaryFacts exports a boundaryFact for each function of the package marked using BoundaryDirective
+func exportBoundaryFacts(pass *analysis.Pass) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !hasBoundaryDirective(fn.Doc) {
+				continue
+			}
+
+			if obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func); ok {
+				pass.ExportObjectFact(obj, new(boundaryFact))
+			}
+		}
+	}
+}
+
+// hasBoundaryDirective reports whether a line of the doc comment is BoundaryDirective
+func hasBoundaryDirective(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+
+	for _, comment := range doc.List {
+		if strings.TrimSpace(comment.Text) == BoundaryDirective {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBoundary reports whether fn is in the Boundaries registry, it's given using the -boundaries flag,
+// or it's marked using BoundaryDirective
+func isBoundary(pass *analysis.Pass, fn *types.Func) bool {
+	return Boundaries[fn.FullName()] || extraBoundaries[fn.FullName()] || pass.ImportObjectFact(fn, new(boundaryFact))
+}
+
+// callee returns the function or method called by call, or nil, e.g. for a function value
+func callee(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		if selection, ok := pass.TypesInfo.Selections[fun]; ok {
+			fn, _ := selection.Obj().(*types.Func)
+			return fn
+		}
+
+		ident = fun.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := pass.TypesInfo.Uses[ident].(*types.Func)
+
+	return fn
+}
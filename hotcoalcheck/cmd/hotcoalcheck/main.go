@@ -0,0 +1,15 @@
+// Command hotcoalcheck runs the hotcoal analyzers.
+//
+// You can run it using go vet:
+//
+//	go vet -vettool=$(which hotcoalcheck) ./...
+package main
+
+import (
+	"github.com/motrboat/hotcoal/hotcoalcheck"
+	"golang.org/x/tools/go/analysis/multichecker"
+)
+
+func main() {
+	multichecker.Main(hotcoalcheck.Analyzers...)
+}
@@ -0,0 +1,84 @@
+package hotcoalcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// ConcatAnalyzer reports string constants, which are concatenated to a hotcoalString
+// without being wrapped using hotcoal.Wrap or hotcoal.W
+//
+// A string variable concatenated to a hotcoalString doesn't compile,
+// but a string constant is implicitly converted to a hotcoalString.
+// This compiles, but it's less safe and we don't encourage it:
+//
+//	query := validatedColumnName + " = ?"
+//
+// Please wrap all your string constants in hotcoalStrings:
+//
+//	query := validatedColumnName + hotcoal.Wrap(" = ?")
+var ConcatAnalyzer = &analysis.Analyzer{
+	Name: "hotcoalconcat",
+	Doc:  "reports string constants concatenated to a hotcoalString without hotcoal.Wrap",
+	Run:  runConcat,
+}
+
+func runConcat(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.BinaryExpr:
+				if node.Op != token.ADD || !isHotcoalString(pass.TypesInfo.TypeOf(node)) {
+					return true
+				}
+
+				if isImplicitConstant(pass, node) {
+					// the whole expression is reported as an operand of its parent
+					return false
+				}
+
+				checkConcatOperand(pass, node.X)
+				checkConcatOperand(pass, node.Y)
+
+			case *ast.AssignStmt:
+				if node.Tok != token.ADD_ASSIGN {
+					return true
+				}
+
+				for i, rhs := range node.Rhs {
+					if isHotcoalString(pass.TypesInfo.TypeOf(node.Lhs[i])) {
+						checkConcatOperand(pass, rhs)
+					}
+				}
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isImplicitConstant reports whether expr is a string constant, which was implicitly
+// converted to a hotcoalString. Outside of the hotcoal package you can't declare
+// a constant of type hotcoalString, so every such constant was converted implicitly.
+func isImplicitConstant(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+
+	return ok && tv.Value != nil && isHotcoalString(tv.Type)
+}
+
+func checkConcatOperand(pass *analysis.Pass, expr ast.Expr) {
+	if !isImplicitConstant(pass, expr) {
+		return
+	}
+
+	pass.Reportf(
+		expr.Pos(),
+		"string constant %s is concatenated to a hotcoalString, please wrap it using hotcoal.Wrap",
+		types.ExprString(expr),
+	)
+}
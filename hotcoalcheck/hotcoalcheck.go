@@ -0,0 +1,41 @@
+// Package hotcoalcheck provides static analyzers, which enforce the conventions of
+// the hotcoal package https://pkg.go.dev/github.com/motrboat/hotcoal
+//
+// You can run them using go vet:
+//
+//	go install github.com/motrboat/hotcoal/hotcoalcheck/cmd/hotcoalcheck@latest
+//	go vet -vettool=$(which hotcoalcheck) ./...
+//
+// It is a separate module, so the hotcoal package stays free of dependencies.
+package hotcoalcheck
+
+import (
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzers contains all the hotcoal analyzers
+var Analyzers = []*analysis.Analyzer{
+	ConcatAnalyzer,
+	SQLAnalyzer,
+}
+
+const hotcoalPath = "github.com/motrboat/hotcoal"
+
+// isHotcoalString reports whether t is the hotcoalString type of the hotcoal package
+func isHotcoalString(t types.Type) bool {
+	return isHotcoalType(t, "hotcoalString")
+}
+
+// isHotcoalType reports whether t is the named type of the hotcoal package
+func isHotcoalType(t types.Type, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == hotcoalPath && obj.Name() == name
+}
@@ -0,0 +1,101 @@
+package hotcoalcheck_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/motrboat/hotcoal/hotcoalcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestConcatAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), hotcoalcheck.ConcatAnalyzer, "concat")
+}
+
+func TestSQLAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), hotcoalcheck.SQLAnalyzer, "sqlflow")
+}
+
+func TestSQLAnalyzerBoundary(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), hotcoalcheck.SQLAnalyzer, "boundary")
+}
+
+func TestSQLAnalyzerBoundariesFlag(t *testing.T) {
+	boundaries := hotcoalcheck.SQLAnalyzer.Flags.Lookup("boundaries").Value
+	previous := boundaries.String()
+	t.Cleanup(func() {
+		boundaries.Set(previous)
+	})
+
+	if err := boundaries.Set("rebind.Configured"); err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, analysistest.TestData(), hotcoalcheck.SQLAnalyzer, "flagboundary")
+}
+
+// TestBoundariesRegistry checks that the functions of hotcoal marked using BoundaryDirective
+// and the hotcoal functions in the Boundaries registry are the same
+func TestBoundariesRegistry(t *testing.T) {
+	const hotcoalPath = "github.com/motrboat/hotcoal"
+
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, "..", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	marked := map[string]bool{}
+
+	for _, file := range pkgs["hotcoal"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Doc == nil {
+				continue
+			}
+
+			for _, comment := range fn.Doc.List {
+				if strings.TrimSpace(comment.Text) == hotcoalcheck.BoundaryDirective {
+					marked[fullName(hotcoalPath, fn)] = true
+				}
+			}
+		}
+	}
+
+	if len(marked) == 0 {
+		t.Fatal("no marked functions")
+	}
+
+	for name := range marked {
+		if !hotcoalcheck.Boundaries[name] {
+			t.Errorf("%s is marked, but it's not in the registry", name)
+		}
+	}
+
+	for name := range hotcoalcheck.Boundaries {
+		if strings.Contains(name, hotcoalPath+".") && !marked[name] {
+			t.Errorf("%s is in the registry, but it's not marked", name)
+		}
+	}
+}
+
+// fullName returns the name of fn in the format of types.Func.FullName
+func fullName(pkgPath string, fn *ast.FuncDecl) string {
+	if fn.Recv == nil {
+		return pkgPath + "." + fn.Name.Name
+	}
+
+	switch recv := fn.Recv.List[0].Type.(type) {
+	case *ast.StarExpr:
+		return "(*" + pkgPath + "." + recv.X.(*ast.Ident).Name + ")." + fn.Name.Name
+	default:
+		return "(" + pkgPath + "." + recv.(*ast.Ident).Name + ")." + fn.Name.Name
+	}
+}
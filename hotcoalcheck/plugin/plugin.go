@@ -0,0 +1,87 @@
+// Command plugin is a golangci-lint plugin https://golangci-lint.run/contributing/new-linters/
+// providing the hotcoal analyzers. Please build it using the same Go version and dependency versions
+// as your golangci-lint binary:
+//
+//	go build -buildmode=plugin -o hotcoal.so github.com/motrboat/hotcoal/hotcoalcheck/plugin
+//
+// Then add it to your .golangci.yml:
+//
+//	linters-settings:
+//	  custom:
+//	    hotcoal:
+//	      path: hotcoal.so
+//	      description: Secures your handcrafted SQL against injection
+//	      original-url: github.com/motrboat/hotcoal
+//	      settings:
+//	        # additional trusted boundary functions, see hotcoalcheck.BoundaryDirective
+//	        boundaries:
+//	          - "(*example.com/db.Rewriter).Rewrite"
+//
+//	linters:
+//	  enable:
+//	    - hotcoal
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/motrboat/hotcoal/hotcoalcheck"
+	"golang.org/x/tools/go/analysis"
+)
+
+// New returns the hotcoal analyzers, it's called by golangci-lint with the settings of the plugin.
+// The analyzers are package globals, so the boundaries setting replaces the one of any previous call.
+// It returns an error if the settings are not valid.
+func New(conf interface{}) ([]*analysis.Analyzer, error) {
+	boundaries, err := parseBoundaries(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// the setting replaces the boundaries of any previous call
+	if err := hotcoalcheck.SQLAnalyzer.Flags.Set("boundaries", strings.Join(boundaries, ",")); err != nil {
+		return nil, err
+	}
+
+	return hotcoalcheck.Analyzers, nil
+}
+
+// parseBoundaries returns the boundaries setting, conf is nil or the decoded settings map
+func parseBoundaries(conf interface{}) ([]string, error) {
+	if conf == nil {
+		return nil, nil
+	}
+
+	settings, ok := conf.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Hotcoal plugin error - settings must be a map, got %T", conf)
+	}
+
+	var ret []string
+
+	for key, value := range settings {
+		if key != "boundaries" {
+			return nil, fmt.Errorf("Hotcoal plugin error - unknown setting %#v", key)
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Hotcoal plugin error - boundaries must be a list, got %T", value)
+		}
+
+		for _, item := range items {
+			name, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("Hotcoal plugin error - boundaries must contain strings, got %T", item)
+			}
+
+			ret = append(ret, name)
+		}
+	}
+
+	return ret, nil
+}
+
+// main is never called, it allows building the package without -buildmode=plugin, e.g. go build ./...
+func main() {}
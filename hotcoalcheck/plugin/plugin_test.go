@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/motrboat/hotcoal/hotcoalcheck"
+)
+
+func TestNew(t *testing.T) {
+	boundaries := hotcoalcheck.SQLAnalyzer.Flags.Lookup("boundaries").Value
+	previous := boundaries.String()
+	t.Cleanup(func() {
+		boundaries.Set(previous)
+	})
+
+	analyzers, err := New(nil)
+	if len(hotcoalcheck.Analyzers) != len(analyzers) || err != nil {
+		t.Fail()
+	}
+
+	_, err = New(map[string]interface{}{
+		"boundaries": []interface{}{"(*example.com/db.Rewriter).Rewrite", "example.com/db.Rebind"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if "(*example.com/db.Rewriter).Rewrite,example.com/db.Rebind" != boundaries.String() {
+		t.Fail()
+	}
+
+	_, err = New(map[string]interface{}{
+		"boundaries": []interface{}{"example.com/db.Rebind"},
+	})
+	if err != nil || "example.com/db.Rebind" != boundaries.String() {
+		t.Fail()
+	}
+
+	_, err = New(nil)
+	if err != nil || "" != boundaries.String() {
+		t.Fail()
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	for expected, conf := range map[string]interface{}{
+		`Hotcoal plugin error - settings must be a map, got string`:           "boundaries",
+		`Hotcoal plugin error - unknown setting "boundary"`:                   map[string]interface{}{"boundary": []interface{}{}},
+		`Hotcoal plugin error - boundaries must be a list, got string`:        map[string]interface{}{"boundaries": "example.com/db.Rebind"},
+		`Hotcoal plugin error - boundaries must contain strings, got float64`: map[string]interface{}{"boundaries": []interface{}{1.0}},
+	} {
+		analyzers, err := New(conf)
+		if analyzers != nil || err == nil || expected != err.Error() {
+			t.Error(expected)
+		}
+	}
+}
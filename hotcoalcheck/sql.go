@@ -0,0 +1,329 @@
+package hotcoalcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// SQLAnalyzer reports hotcoal SQL, which was converted to a plain string using the String method,
+// and then modified as a plain string before being passed to database/sql.
+// This loses the protection of hotcoal. Passing the result of String directly is fine:
+//
+//	db.Query(query.String(), args...)              // OK
+//	db.Query("SELECT "+query.String(), args...)    // reported
+//
+// It also follows local variables, which were assigned the result of String and then modified.
+// Besides String, it trusts the results of the boundary functions, see BoundaryDirective:
+//
+//	db.Query(sqlx.Rebind(sqlx.DOLLAR, query.String()), args...) // OK
+//
+// You can trust more functions using the -boundaries flag, e.g. the functions of libraries you can't mark.
+var SQLAnalyzer = &analysis.Analyzer{
+	Name:      "hotcoalsql",
+	Doc:       "reports hotcoal SQL modified as a plain string before being passed to database/sql",
+	Run:       runSQL,
+	FactTypes: []analysis.Fact{new(boundaryFact)},
+}
+
+func init() {
+	SQLAnalyzer.Flags.Var(extraBoundaries, "boundaries",
+		"comma-separated full names of additional boundary functions, e.g. (*example.com/db.Rewriter).Rewrite")
+}
+
+// sqlQueryMethods maps the database/sql methods to the index of their query argument
+var sqlQueryMethods = map[string]int{
+	"Exec":            0,
+	"ExecContext":     1,
+	"Prepare":         0,
+	"PrepareContext":  1,
+	"Query":           0,
+	"QueryContext":    1,
+	"QueryRow":        0,
+	"QueryRowContext": 1,
+}
+
+// sqlQueryTypes are the database/sql types, whose methods take a query
+var sqlQueryTypes = map[string]bool{
+	"DB":   true,
+	"Tx":   true,
+	"Conn": true,
+}
+
+// sqlAssignment is a value assigned to a local variable
+type sqlAssignment struct {
+	tok token.Token
+	rhs ast.Expr // nil, if it's not known, e.g. a function with multiple results
+}
+
+// sqlChecker checks the query arguments of a function
+type sqlChecker struct {
+	pass        *analysis.Pass
+	assignments map[*types.Var][]sqlAssignment
+
+	// visiting are the variables being checked, e.g. q in q = sqlx.Rebind(sqlx.DOLLAR, q)
+	visiting map[*types.Var]bool
+
+	// deriving are the variables being checked by isDerived
+	deriving map[*types.Var]bool
+}
+
+func runSQL(pass *analysis.Pass) (interface{}, error) {
+	exportBoundaryFacts(pass)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			checker := &sqlChecker{
+				pass:        pass,
+				assignments: collectAssignments(pass, fn.Body),
+				visiting:    map[*types.Var]bool{},
+				deriving:    map[*types.Var]bool{},
+			}
+
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				method, index, ok := sqlQueryMethod(pass, call)
+				if !ok || index >= len(call.Args) {
+					return true
+				}
+
+				if checker.isModifiedHotcoalSQL(call.Args[index]) {
+					pass.Reportf(
+						call.Args[index].Pos(),
+						"hotcoal SQL is modified as a plain string before being passed to %s, please handcraft SQL using hotcoalStrings",
+						method,
+					)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil, nil
+}
+
+// sqlQueryMethod returns the name of the database/sql method called by call,
+// and the index of its query argument
+func sqlQueryMethod(pass *analysis.Pass, call *ast.CallExpr) (string, int, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", 0, false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return "", 0, false
+	}
+
+	recv := selection.Recv()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+
+	named, ok := recv.(*types.Named)
+	if !ok {
+		return "", 0, false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != "database/sql" || !sqlQueryTypes[obj.Name()] {
+		return "", 0, false
+	}
+
+	index, ok := sqlQueryMethods[sel.Sel.Name]
+
+	return "sql." + obj.Name() + "." + sel.Sel.Name, index, ok
+}
+
+// collectAssignments returns the values assigned to local variables in body
+func collectAssignments(pass *analysis.Pass, body *ast.BlockStmt) map[*types.Var][]sqlAssignment {
+	ret := map[*types.Var][]sqlAssignment{}
+
+	add := func(ident *ast.Ident, tok token.Token, rhs ast.Expr) {
+		obj := pass.TypesInfo.ObjectOf(ident)
+		if v, ok := obj.(*types.Var); ok {
+			ret[v] = append(ret[v], sqlAssignment{tok: tok, rhs: rhs})
+		}
+	}
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range node.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok {
+					continue
+				}
+
+				var rhs ast.Expr
+				if len(node.Lhs) == len(node.Rhs) {
+					rhs = node.Rhs[i]
+				}
+
+				add(ident, node.Tok, rhs)
+			}
+
+		case *ast.ValueSpec:
+			for i, ident := range node.Names {
+				if i < len(node.Values) && len(node.Names) == len(node.Values) {
+					add(ident, token.DEFINE, node.Values[i])
+				}
+			}
+		}
+
+		return true
+	})
+
+	return ret
+}
+
+// isModifiedHotcoalSQL reports whether expr is derived from hotcoal SQL, e.g. the result of String,
+// but isn't the direct result of a boundary function
+func (c *sqlChecker) isModifiedHotcoalSQL(expr ast.Expr) bool {
+	expr = unparen(expr)
+
+	if c.isTrustedCall(expr) {
+		return false
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		if v, ok := c.pass.TypesInfo.ObjectOf(ident).(*types.Var); ok {
+			if values, ok := c.assignments[v]; ok {
+				if c.visiting[v] {
+					// the other assignments of the variable decide
+					return false
+				}
+
+				c.visiting[v] = true
+				defer delete(c.visiting, v)
+
+				return c.isModifiedHotcoalVar(values)
+			}
+		}
+	}
+
+	return c.isDerived(expr)
+}
+
+// isModifiedHotcoalVar reports whether a variable was assigned hotcoal SQL,
+// and it was modified or derived from it
+func (c *sqlChecker) isModifiedHotcoalVar(values []sqlAssignment) bool {
+	derived := false
+	modified := false
+
+	for _, value := range values {
+		if value.rhs == nil {
+			modified = true
+			continue
+		}
+
+		if c.isDerived(value.rhs) {
+			derived = true
+		}
+
+		direct := (value.tok == token.DEFINE || value.tok == token.ASSIGN) &&
+			(c.isTrustedCall(unparen(value.rhs)) || c.isUnmodifiedCopy(unparen(value.rhs)))
+
+		if !direct {
+			modified = true
+		}
+	}
+
+	return derived && modified
+}
+
+// isUnmodifiedCopy reports whether expr is a variable, which isn't modified hotcoal SQL,
+// e.g. second in first = second, so copying it doesn't modify the SQL
+func (c *sqlChecker) isUnmodifiedCopy(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	if _, ok := c.pass.TypesInfo.ObjectOf(ident).(*types.Var); !ok {
+		return false
+	}
+
+	return !c.isModifiedHotcoalSQL(ident)
+}
+
+// isTrustedCall reports whether expr is a call of a boundary function,
+// e.g. the String method of a hotcoalString, whose arguments aren't modified hotcoal SQL
+func (c *sqlChecker) isTrustedCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	fn := callee(c.pass, call)
+	if fn == nil || !isBoundary(c.pass, fn) {
+		return false
+	}
+
+	for _, arg := range call.Args {
+		if c.isModifiedHotcoalSQL(arg) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isDerived reports whether expr calls a boundary function, e.g. the String method of a hotcoalString,
+// or uses a local variable, which was assigned such a value
+func (c *sqlChecker) isDerived(expr ast.Expr) bool {
+	found := false
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			if fn := callee(c.pass, node); fn != nil && isBoundary(c.pass, fn) {
+				found = true
+			}
+
+		case *ast.Ident:
+			v, ok := c.pass.TypesInfo.ObjectOf(node).(*types.Var)
+			if !ok || c.deriving[v] {
+				break
+			}
+
+			c.deriving[v] = true
+
+			for _, value := range c.assignments[v] {
+				if value.rhs != nil && c.isDerived(value.rhs) {
+					found = true
+				}
+			}
+
+			delete(c.deriving, v)
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// unparen returns expr with any enclosing parentheses removed
+func unparen(expr ast.Expr) ast.Expr {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			return expr
+		}
+
+		expr = paren.X
+	}
+}
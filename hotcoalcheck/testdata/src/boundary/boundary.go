@@ -0,0 +1,52 @@
+package boundary
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/motrboat/hotcoal"
+	"rebind"
+)
+
+//hotcoal:boundary
+func localRebind(query string) string { // want localRebind:"hotcoalBoundary"
+	return query
+}
+
+// localNoinline is a trusted boundary, the directive doesn't have to be the last line
+//
+//hotcoal:boundary
+//go:noinline
+func localNoinline(query string) string { // want localNoinline:"hotcoalBoundary"
+	return query
+}
+
+//hotcoal:boundary
+
+// notDoc is not a trusted boundary, the directive is not part of its doc comment
+func notDoc(query string) string {
+	return query
+}
+
+func f(db *sql.DB) {
+	query := hotcoal.W("SELECT * FROM users WHERE id = ?")
+
+	db.Query(sqlx.Rebind(sqlx.DOLLAR, query.String())) // OK, in the registry
+	db.Query(rebind.Rebind(query.String()))            // OK, marked in its package
+	db.Query(localRebind(query.String()))              // OK, marked in this package
+	db.Query(localNoinline(query.String()))            // OK, marked before another directive
+	db.Query(sqlx.Rebind(sqlx.DOLLAR, "SELECT 1"))     // OK, no hotcoal
+
+	db.Query(rebind.Unmarked(query.String()))                       // want `sql.DB.Query`
+	db.Query(notDoc(query.String()))                                // want `sql.DB.Query`
+	db.Query(sqlx.Rebind(sqlx.DOLLAR, query.String()+" LIMIT 1"))   // want `sql.DB.Query`
+	db.Query("EXPLAIN " + sqlx.Rebind(sqlx.DOLLAR, query.String())) // want `sql.DB.Query`
+
+	rebound := query.String()
+	rebound = sqlx.Rebind(sqlx.DOLLAR, rebound)
+	db.Query(rebound) // OK
+
+	modified := query.String()
+	modified = sqlx.Rebind(sqlx.DOLLAR, modified+" LIMIT 1")
+	db.Query(modified) // want `sql.DB.Query`
+}
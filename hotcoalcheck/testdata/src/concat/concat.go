@@ -0,0 +1,27 @@
+package concat
+
+import "github.com/motrboat/hotcoal"
+
+const suffix = " = ?"
+
+func f(column string) {
+	validated := hotcoal.W("first_name")
+
+	_ = validated + hotcoal.Wrap(" = ?") // OK
+	_ = hotcoal.W("SELECT ") + validated // OK
+	_ = hotcoal.W("foo" + "bar")         // OK, the constant is wrapped
+
+	_ = validated + " = ?"                           // want `string constant " = \?" is concatenated to a hotcoalString`
+	_ = "SELECT " + validated                        // want `string constant "SELECT " is concatenated to a hotcoalString`
+	_ = validated + suffix                           // want `string constant suffix is concatenated to a hotcoalString`
+	_ = validated + (" = " + "?")                    // want `string constant \(" = " \+ "\?"\) is concatenated to a hotcoalString`
+	_ = hotcoal.W("SELECT ") + validated + " FROM x" // want `string constant " FROM x" is concatenated to a hotcoalString`
+
+	validated += hotcoal.W(" = ?") // OK
+	validated += " = ?"            // want `string constant " = \?" is concatenated to a hotcoalString`
+
+	var b hotcoal.Builder
+	b.Write("SELECT ") // OK, not a concatenation
+
+	_ = column + " = ?" // OK, not a hotcoalString
+}
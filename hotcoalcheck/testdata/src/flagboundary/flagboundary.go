@@ -0,0 +1,15 @@
+package flagboundary
+
+import (
+	"database/sql"
+
+	"github.com/motrboat/hotcoal"
+	"rebind"
+)
+
+func f(db *sql.DB) {
+	query := hotcoal.W("SELECT * FROM users WHERE id = ?")
+
+	db.Query(rebind.Configured(query.String()))              // OK, given using the -boundaries flag
+	db.Query(rebind.Configured(query.String() + " LIMIT 1")) // want `sql.DB.Query`
+}
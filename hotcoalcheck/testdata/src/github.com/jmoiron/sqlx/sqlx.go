@@ -0,0 +1,8 @@
+// Package sqlx is a minimal copy of the sqlx API, used by the analyzer tests
+package sqlx
+
+const DOLLAR = 2
+
+func Rebind(bindType int, query string) string {
+	return query
+}
@@ -0,0 +1,35 @@
+// Package hotcoal is a minimal copy of the hotcoal API, used by the analyzer tests
+package hotcoal
+
+type hotcoalString string
+
+type Slice []hotcoalString
+
+func (s hotcoalString) String() string {
+	return string(s)
+}
+
+func Wrap(s hotcoalString) hotcoalString {
+	return s
+}
+
+func W(s hotcoalString) hotcoalString {
+	return s
+}
+
+type Builder struct {
+	s hotcoalString
+}
+
+func (b *Builder) Write(s hotcoalString) *Builder {
+	b.s += s
+	return b
+}
+
+func (b *Builder) HotcoalString() hotcoalString {
+	return b.s
+}
+
+func (b *Builder) String() string {
+	return string(b.s)
+}
@@ -0,0 +1,18 @@
+package rebind
+
+// Rebind is a trusted boundary
+//
+//hotcoal:boundary
+func Rebind(query string) string {
+	return query
+}
+
+// Unmarked is not a trusted boundary
+func Unmarked(query string) string {
+	return query
+}
+
+// Configured is a trusted boundary, only if it's given using the -boundaries flag
+func Configured(query string) string {
+	return query
+}
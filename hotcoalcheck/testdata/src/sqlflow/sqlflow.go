@@ -0,0 +1,91 @@
+package sqlflow
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/motrboat/hotcoal"
+)
+
+func f(ctx context.Context, db *sql.DB, tx *sql.Tx, conn *sql.Conn, cond bool) {
+	query := hotcoal.W("SELECT * FROM users")
+
+	db.Query(query.String())                 // OK
+	db.QueryRow((query.String()))            // OK
+	tx.ExecContext(ctx, query.String(), 1)   // OK
+	conn.QueryContext(ctx, query.String())   // OK
+	db.Query("SELECT 1")                     // OK, no hotcoal
+	db.Query(strings.ToUpper("select 1"))    // OK, no hotcoal
+	db.Query((query + " LIMIT 1").String())  // OK, modified as a hotcoalString
+	db.Query(hotcoal.W("SELECT 1").String()) // OK
+
+	db.Query("SELECT " + query.String())                              // want `hotcoal SQL is modified as a plain string before being passed to sql.DB.Query`
+	db.QueryRow(strings.ToUpper(query.String()))                      // want `sql.DB.QueryRow`
+	tx.ExecContext(ctx, query.String()+" LIMIT 1")                    // want `sql.Tx.ExecContext`
+	conn.QueryContext(ctx, fmt.Sprintf("%s LIMIT 1", query.String())) // want `sql.Conn.QueryContext`
+
+	var b hotcoal.Builder
+	b.Write("SELECT 1")
+
+	db.Exec(b.String())                // OK
+	db.Prepare(b.String() + ";")       // want `sql.DB.Prepare`
+	tx.PrepareContext(ctx, b.String()) // OK
+
+	direct := query.String()
+	db.Query(direct)              // OK
+	db.Query(direct + " LIMIT 1") // want `sql.DB.Query`
+
+	var declared = query.String()
+	db.Query(declared) // OK
+
+	reassigned := query.String()
+	if cond {
+		reassigned = b.String()
+	}
+	db.Query(reassigned) // OK
+
+	modified := query.String()
+	modified += " LIMIT 1"
+	db.Query(modified) // want `sql.DB.Query`
+
+	concatenated := "SELECT " + b.String()
+	db.QueryRowContext(ctx, concatenated) // want `sql.DB.QueryRowContext`
+
+	plain := "SELECT 1"
+	plain += " LIMIT 1"
+	db.Query(plain) // OK, no hotcoal
+
+	func() {
+		db.Query(modified) // want `sql.DB.Query`
+	}()
+}
+
+func cycles(db *sql.DB, cond bool) {
+	query := hotcoal.W("SELECT * FROM users")
+
+	first := query.String()
+	second := first
+	if cond {
+		first = second
+	}
+	db.Query(first)  // OK, the cycle doesn't modify the SQL
+	db.Query(second) // OK
+
+	loop := query.String()
+	for i := 0; i < 3; i++ {
+		loop = loop + " LIMIT 1"
+	}
+	db.Query(loop) // want `sql.DB.Query`
+
+	copied := loop
+	db.Query(copied) // want `sql.DB.Query`
+
+	left := query.String()
+	right := left + " LIMIT 1"
+	if cond {
+		left = right
+	}
+	db.Query(left) // want `sql.DB.Query`
+}
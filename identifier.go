@@ -0,0 +1,130 @@
+package hotcoal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxIdentifierLength is the maximum length of an identifier in bytes, accepted by ValidateIdentifier.
+// It's the limit of PostgreSQL, which is the lowest of the common databases.
+const MaxIdentifierLength = 63
+
+// ErrInvalidIdentifier is returned by ValidateIdentifier, when the value is not a valid identifier.
+// You can check for it using errors.Is
+var ErrInvalidIdentifier = errors.New("Hotcoal validation error - value is not a valid identifier")
+
+// ReservedWords is a case-insensitive allowlist of common SQL reserved words.
+// ValidateIdentifier accepts them, if you want to reject them, please check them yourself:
+//
+//	if hotcoal.ReservedWords.Contains(columnName) {
+//		// ...
+//	}
+var ReservedWords = AllowlistFold(
+	"ALL", "ALTER", "AND", "ANY", "AS", "ASC", "BETWEEN", "BY", "CASE", "CHECK",
+	"COLUMN", "CONSTRAINT", "CREATE", "CROSS", "DEFAULT", "DELETE", "DESC", "DISTINCT", "DROP", "ELSE",
+	"END", "EXCEPT", "EXISTS", "FALSE", "FETCH", "FOR", "FOREIGN", "FROM", "FULL", "GRANT",
+	"GROUP", "HAVING", "IN", "INNER", "INSERT", "INTERSECT", "INTO", "IS", "JOIN", "KEY",
+	"LEFT", "LIKE", "LIMIT", "NATURAL", "NOT", "NULL", "OFFSET", "ON", "OR", "ORDER",
+	"OUTER", "PRIMARY", "REFERENCES", "RIGHT", "SELECT", "SET", "TABLE", "THEN", "TO", "TRUE",
+	"UNION", "UNIQUE", "UPDATE", "USER", "USING", "VALUES", "WHEN", "WHERE", "WITH",
+)
+
+// ValidateIdentifier validates a string variable as an SQL identifier, such as a column name or a table name,
+// and returns a hotcoalString. It's useful when the identifiers can't be enumerated in an allowlist.
+//
+// A valid identifier starts with an ASCII letter or an underscore, followed by ASCII letters, digits,
+// underscores or dollar signs, and it is at most MaxIdentifierLength bytes long.
+// Otherwise it returns an error, which wraps ErrInvalidIdentifier.
+//
+// It accepts SQL reserved words, please see ReservedWords.
+// If possible, please use an Allowlist instead, which is stricter.
+//
+//hotcoal:boundary
+func ValidateIdentifier(value string) (hotcoalString, error) {
+	if value == "" {
+		return "", invalidIdentifierError(value, "it is empty")
+	}
+
+	if len(value) > MaxIdentifierLength {
+		return "", invalidIdentifierError(value, fmt.Sprintf("it is longer than %d bytes", MaxIdentifierLength))
+	}
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		valid := c == '_' ||
+			('a' <= c && c <= 'z') ||
+			('A' <= c && c <= 'Z') ||
+			(i > 0 && (c == '$' || ('0' <= c && c <= '9')))
+
+		if !valid {
+			return "", invalidIdentifierError(value, fmt.Sprintf("it contains %q at position %d", c, i))
+		}
+	}
+
+	return hotcoalString(value), nil
+}
+
+// ValidateQuotedIdentifier validates a string variable the same way as ValidateIdentifier,
+// and returns it as a hotcoalString quoted using double quotes, e.g. "first_name",
+// which is the quoting of ANSI SQL, PostgreSQL and SQLite.
+//
+//hotcoal:boundary
+func ValidateQuotedIdentifier(value string) (hotcoalString, error) {
+	identifier, err := ValidateIdentifier(value)
+	if err != nil {
+		return "", err
+	}
+
+	return `"` + identifier + `"`, nil
+}
+
+// ValidateQualified validates the segments of a qualified name, e.g. "public.users.first_name"
+// split by the dots, and returns the validated segments joined by dots.
+// Segment i is validated against allowlist i, so the number of segments must equal
+// the number of allowlists. If there's only one allowlist, all the segments are validated against it.
+// If the number of segments is unexpected, it returns an error, which wraps ErrInvalidIdentifier.
+// If any segment is not in its allowlist, it returns the error of the first one.
+//
+// The allowlist items are joined as they are, so if the name needs quoting,
+// please put the quoted identifiers into the allowlists, e.g. using AllowlistMap.
+//
+//hotcoal:boundary
+func ValidateQualified(segments []string, allowlists ...allowlistT) (hotcoalString, error) {
+	if len(allowlists) == 0 {
+		return "", errors.New("Hotcoal validation error - no allowlists for qualified name")
+	}
+
+	name := strings.Join(segments, ".")
+
+	if len(segments) == 0 {
+		return "", invalidIdentifierError(name, "it has no segments")
+	}
+
+	if len(allowlists) > 1 && len(segments) != len(allowlists) {
+		return "", invalidIdentifierError(name, fmt.Sprintf("it has %d segments instead of %d", len(segments), len(allowlists)))
+	}
+
+	ret := make(Slice, 0, len(segments))
+
+	for i, segment := range segments {
+		allowlist := allowlists[0]
+		if len(allowlists) > 1 {
+			allowlist = allowlists[i]
+		}
+
+		item, err := allowlist.Validate(segment)
+		if err != nil {
+			return "", err
+		}
+
+		ret = append(ret, item)
+	}
+
+	return ret.Join("."), nil
+}
+
+func invalidIdentifierError(value, reason string) error {
+	return fmt.Errorf("%w %#v, %s", ErrInvalidIdentifier, value, reason)
+}
@@ -0,0 +1,149 @@
+package hotcoal
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateIdentifier(t *testing.T) {
+	for _, el := range []string{"a", "_", "first_name", "Users", "tenant_42", "price$", "_1", strings.Repeat("a", 63)} {
+		hs, err := ValidateIdentifier(el)
+		if el != hs.String() || err != nil {
+			t.Errorf("%q should be valid", el)
+		}
+	}
+}
+
+func TestValidateIdentifierError(t *testing.T) {
+	for _, el := range []string{
+		"",
+		"1st",
+		"$price",
+		"first name",
+		"first-name",
+		`a"; DROP TABLE users; --`,
+		"a'; DROP TABLE users; --",
+		"a`",
+		"a]",
+		"a;",
+		"a\x00",
+		"naïve",
+		strings.Repeat("a", 64),
+	} {
+		hs, err := ValidateIdentifier(el)
+		if "" != hs.String() || !errors.Is(err, ErrInvalidIdentifier) {
+			t.Errorf("%q should not be valid", el)
+		}
+	}
+
+	_, err := ValidateIdentifier(`a"; DROP`)
+	if `Hotcoal validation error - value is not a valid identifier "a\"; DROP", it contains '"' at position 1` != err.Error() {
+		t.Error(err)
+	}
+}
+
+func TestValidateQuotedIdentifier(t *testing.T) {
+	hs, err := ValidateQuotedIdentifier("first_name")
+	if `"first_name"` != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = ValidateQuotedIdentifier("order")
+	if `"order"` != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	for _, el := range []string{"", `a"; DROP`, `a""`, "a b"} {
+		hs, err := ValidateQuotedIdentifier(el)
+		if "" != hs.String() || !errors.Is(err, ErrInvalidIdentifier) {
+			t.Errorf("%q should not be valid", el)
+		}
+	}
+}
+
+func TestReservedWords(t *testing.T) {
+	for _, el := range []string{"select", "SELECT", "Order", "null"} {
+		if !ReservedWords.Contains(el) {
+			t.Errorf("%q should be reserved", el)
+		}
+	}
+
+	for _, el := range []string{"first_name", "users", "selected"} {
+		if ReservedWords.Contains(el) {
+			t.Errorf("%q should not be reserved", el)
+		}
+	}
+}
+
+func TestValidateQualified(t *testing.T) {
+	schemas := Allowlist("public", "audit")
+	tables := Allowlist("users", "orders")
+	columns := Allowlist("id", "first_name")
+
+	hs, err := ValidateQualified([]string{"public", "users", "first_name"}, schemas, tables, columns)
+	if "public.users.first_name" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = ValidateQualified(strings.Split("users.id", "."), tables, columns)
+	if "users.id" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	// a single allowlist validates all the segments
+	hs, err = ValidateQualified([]string{"users", "id"}, Union(tables, columns))
+	if "users.id" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = ValidateQualified([]string{"id"}, columns)
+	if "id" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	quoted := AllowlistMap(Map{"public": `"public"`, "users": `"users"`})
+
+	hs, err = ValidateQualified([]string{"public", "users"}, quoted)
+	if `"public"."users"` != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestValidateQualifiedError(t *testing.T) {
+	tables := Allowlist("users")
+	columns := Allowlist("id")
+
+	hs, err := ValidateQualified([]string{"users", "password"}, tables, columns)
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	// segment i is validated against allowlist i only
+	hs, err = ValidateQualified([]string{"id", "users"}, tables, columns)
+	if "" != hs.String() || !errors.Is(err, ErrNotInAllowlist) {
+		t.Fail()
+	}
+
+	for _, el := range [][]string{{"id"}, {"public", "users", "id"}, nil} {
+		hs, err = ValidateQualified(el, tables, columns)
+		if "" != hs.String() || !errors.Is(err, ErrInvalidIdentifier) {
+			t.Error(el, err)
+		}
+	}
+
+	hs, err = ValidateQualified(nil, tables)
+	if "" != hs.String() || !errors.Is(err, ErrInvalidIdentifier) {
+		t.Fail()
+	}
+
+	hs, err = ValidateQualified([]string{"users"})
+	if "" != hs.String() || err == nil {
+		t.Fail()
+	}
+
+	_, err = ValidateQualified([]string{"users", "id", "x"}, tables, columns)
+	if `Hotcoal validation error - value is not a valid identifier "users.id.x", it has 3 segments instead of 2` != err.Error() {
+		t.Fail()
+	}
+}
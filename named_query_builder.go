@@ -0,0 +1,78 @@
+package hotcoal
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// NamedQueryBuilder is used to efficiently build a query with named parameters,
+// e.g. "@id" for SQLServer, or ":id" for drivers and libraries like sqlx.
+// It collects the arguments as sql.NamedArg https://pkg.go.dev/database/sql#NamedArg
+// Create it using NewNamedQueryBuilder, the zero value is ready to use with the "@" prefix.
+// Do not copy a non-zero NamedQueryBuilder.
+type NamedQueryBuilder struct {
+	prefix  hotcoalString
+	builder Builder
+	args    []sql.NamedArg
+}
+
+// NewNamedQueryBuilder creates a NamedQueryBuilder, which writes the named parameters
+// with the prefix, e.g. "@" or ":".
+func NewNamedQueryBuilder(prefix hotcoalString) *NamedQueryBuilder {
+	return &NamedQueryBuilder{prefix: prefix}
+}
+
+// WriteSQL appends sql to the query. It returns b, you can chain method calls.
+func (b *NamedQueryBuilder) WriteSQL(sql hotcoalString) *NamedQueryBuilder {
+	b.builder.Write(sql)
+
+	return b
+}
+
+// WriteNamedParam appends the named parameter, e.g. "@id", to the query
+// and value to its arguments. It returns b, you can chain method calls.
+//
+// The name must start with an ASCII letter or an underscore, followed by ASCII letters,
+// digits or underscores, and it must not be used twice, otherwise WriteNamedParam panics.
+func (b *NamedQueryBuilder) WriteNamedParam(name string, value interface{}) *NamedQueryBuilder {
+	if !isName(name) {
+		panic(fmt.Sprintf("Hotcoal NamedQueryBuilder.WriteNamedParam received invalid name %#v", name))
+	}
+
+	for _, el := range b.args {
+		if el.Name == name {
+			panic(fmt.Sprintf("Hotcoal NamedQueryBuilder.WriteNamedParam received duplicate name %#v", name))
+		}
+	}
+
+	prefix := b.prefix
+	if prefix == "" {
+		prefix = "@"
+	}
+
+	// the name is validated above, so it's safe
+	b.builder.Write(prefix).Write(hotcoalString(name))
+	b.args = append(b.args, sql.Named(name, value))
+
+	return b
+}
+
+// Build returns the SQL as a plain string, along with the named arguments.
+//
+//hotcoal:boundary
+func (b *NamedQueryBuilder) Build() (string, []sql.NamedArg) {
+	return b.builder.String(), b.args
+}
+
+// BuildArgs is like Build, but returns the named arguments as a []interface{},
+// so you can pass them to your SQL library, e.g. db.Query(b.BuildArgs())
+//
+//hotcoal:boundary
+func (b *NamedQueryBuilder) BuildArgs() (string, []interface{}) {
+	args := make([]interface{}, 0, len(b.args))
+	for _, el := range b.args {
+		args = append(args, el)
+	}
+
+	return b.builder.String(), args
+}
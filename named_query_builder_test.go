@@ -0,0 +1,62 @@
+package hotcoal
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+func TestNamedQueryBuilder(t *testing.T) {
+	var b NamedQueryBuilder
+
+	b.WriteSQL("SELECT * FROM users WHERE id = ").WriteNamedParam("id", 42)
+	b.WriteSQL(" AND last_name = ").WriteNamedParam("last_name", "Smith")
+
+	query, args := b.Build()
+	if "SELECT * FROM users WHERE id = @id AND last_name = @last_name" != query {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]sql.NamedArg{sql.Named("id", 42), sql.Named("last_name", "Smith")}, args) {
+		t.Fail()
+	}
+
+	query, anyArgs := b.BuildArgs()
+	if "SELECT * FROM users WHERE id = @id AND last_name = @last_name" != query {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]interface{}{sql.Named("id", 42), sql.Named("last_name", "Smith")}, anyArgs) {
+		t.Fail()
+	}
+}
+
+func TestNewNamedQueryBuilder(t *testing.T) {
+	query, args := NewNamedQueryBuilder(":").
+		WriteSQL("UPDATE users SET age = ").WriteNamedParam("age", 18).
+		Build()
+
+	if "UPDATE users SET age = :age" != query || !reflect.DeepEqual([]sql.NamedArg{sql.Named("age", 18)}, args) {
+		t.Fail()
+	}
+
+	query, args = NewNamedQueryBuilder(":").Build()
+	if "" != query || args != nil {
+		t.Fail()
+	}
+}
+
+func TestNamedQueryBuilderPanic(t *testing.T) {
+	for _, el := range []string{"", "1id", "id; DROP TABLE users", "first-name", "id", "@id"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error(el)
+				}
+			}()
+
+			var b NamedQueryBuilder
+			b.WriteNamedParam("id", 1).WriteNamedParam(el, 2)
+		}()
+	}
+}
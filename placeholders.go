@@ -0,0 +1,83 @@
+package hotcoal
+
+// Placeholders returns n question mark placeholders separated by commas, e.g. "?, ?, ?".
+// If n is 0, it returns an empty hotcoalString. If n is negative, Placeholders panics.
+//
+// It's useful for building IN lists, e.g. "WHERE id IN (" + Placeholders(len(ids)) + ")".
+func Placeholders(n int) hotcoalString {
+	if n < 0 {
+		panic("Hotcoal Placeholders received negative n")
+	}
+
+	if n == 0 {
+		return ""
+	}
+
+	var b Builder
+	b.Grow(3*n - 2)
+
+	b.Write("?")
+	for i := 1; i < n; i++ {
+		b.Write(", ?")
+	}
+
+	return b.HotcoalString()
+}
+
+// PlaceholdersN returns count numbered placeholders separated by commas, starting at start,
+// e.g. PlaceholdersN(3, 2) returns "$2, $3, $4". This is the placeholder style of PostgreSQL.
+// You can continue numbering across clauses using start.
+// If count is 0, it returns an empty hotcoalString.
+// If count is negative or start is less than 1, PlaceholdersN panics.
+func PlaceholdersN(count, start int) hotcoalString {
+	if count < 0 {
+		panic("Hotcoal PlaceholdersN received negative count")
+	}
+
+	if start < 1 {
+		panic("Hotcoal PlaceholdersN received start less than 1")
+	}
+
+	var b Builder
+
+	for i := 0; i < count; i++ {
+		if i != 0 {
+			b.Write(", ")
+		}
+
+		b.Write("$").WriteInt(start + i)
+	}
+
+	return b.HotcoalString()
+}
+
+// PlaceholderGroups returns rows groups of cols question mark placeholders, separated by commas,
+// e.g. PlaceholderGroups(3, 2) returns "(?, ?), (?, ?), (?, ?)".
+// It's useful for multi-row INSERTs, e.g. "INSERT INTO users (a, b) VALUES " + PlaceholderGroups(len(users), 2).
+// If rows or cols is 0, it returns an empty hotcoalString.
+// If rows or cols is negative, PlaceholderGroups panics.
+func PlaceholderGroups(rows, cols int) hotcoalString {
+	if rows < 0 {
+		panic("Hotcoal PlaceholderGroups received negative rows")
+	}
+
+	if cols < 0 {
+		panic("Hotcoal PlaceholderGroups received negative cols")
+	}
+
+	if rows == 0 || cols == 0 {
+		return ""
+	}
+
+	group := "(" + Placeholders(cols) + ")"
+
+	var b Builder
+	b.Grow(len(group)*rows + 2*(rows-1))
+
+	b.Write(group)
+	for i := 1; i < rows; i++ {
+		b.Write(", ").Write(group)
+	}
+
+	return b.HotcoalString()
+}
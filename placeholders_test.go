@@ -0,0 +1,95 @@
+package hotcoal
+
+import "testing"
+
+func TestPlaceholders(t *testing.T) {
+	if "" != Placeholders(0).String() {
+		t.Fail()
+	}
+
+	if "?" != Placeholders(1).String() {
+		t.Fail()
+	}
+
+	if "?, ?, ?" != Placeholders(3).String() {
+		t.Fail()
+	}
+
+	if Placeholders(1000) != W("?, ").Repeat(1000).TrimSuffix(", ") {
+		t.Fail()
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+
+		Placeholders(-1)
+	}()
+}
+
+func BenchmarkPlaceholders(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Placeholders(100)
+	}
+}
+
+func TestPlaceholdersN(t *testing.T) {
+	if "" != PlaceholdersN(0, 1).String() {
+		t.Fail()
+	}
+
+	if "$1" != PlaceholdersN(1, 1).String() {
+		t.Fail()
+	}
+
+	if "$1, $2, $3" != PlaceholdersN(3, 1).String() {
+		t.Fail()
+	}
+
+	if "$9, $10, $11" != PlaceholdersN(3, 9).String() {
+		t.Fail()
+	}
+
+	for _, args := range [][2]int{{-1, 1}, {1, 0}, {1, -1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+
+			PlaceholdersN(args[0], args[1])
+		}()
+	}
+}
+
+func TestPlaceholderGroups(t *testing.T) {
+	if "(?)" != PlaceholderGroups(1, 1).String() {
+		t.Fail()
+	}
+
+	if "(?, ?), (?, ?), (?, ?)" != PlaceholderGroups(3, 2).String() {
+		t.Fail()
+	}
+
+	if "" != PlaceholderGroups(0, 3).String() || "" != PlaceholderGroups(3, 0).String() {
+		t.Fail()
+	}
+
+	for _, el := range [][2]int{{-1, 1}, {1, -1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+
+			PlaceholderGroups(el[0], el[1])
+		}()
+	}
+}
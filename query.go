@@ -0,0 +1,64 @@
+package hotcoal
+
+import "strings"
+
+// Query bundles a handcrafted SQL hotcoalString with the arguments of its placeholders,
+// so they don't get out of sync. The zero value is an empty query, ready to use.
+type Query struct {
+	SQL  hotcoalString
+	Args []interface{}
+}
+
+// Append appends sql to the query's SQL and args to its arguments.
+// It returns q, you can chain method calls.
+func (q *Query) Append(sql hotcoalString, args ...interface{}) *Query {
+	q.SQL += sql
+	q.Args = append(q.Args, args...)
+
+	return q
+}
+
+// Build returns the SQL as a plain string, along with the arguments,
+// so you can pass them to your SQL library, e.g. db.Query(q.Build())
+//
+//hotcoal:boundary
+func (q *Query) Build() (string, []interface{}) {
+	return q.SQL.String(), q.Args
+}
+
+// Parts returns the SQL split at the "?" placeholders, along with the arguments, e.g. for testing
+// the structure of a query. There's one more chunk than there are placeholders, so joining the chunks
+// with "?" returns the SQL. Question marks in quoted strings and comments are not placeholders.
+func (q *Query) Parts() (Slice, []interface{}) {
+	chunks := Slice{""}
+
+	for _, segment := range splitSQL(string(q.SQL)) {
+		if segment.kind != sqlCode {
+			chunks[len(chunks)-1] += hotcoalString(segment.text)
+			continue
+		}
+
+		parts := strings.Split(segment.text, "?")
+
+		chunks[len(chunks)-1] += hotcoalString(parts[0])
+		for _, part := range parts[1:] {
+			chunks = append(chunks, hotcoalString(part))
+		}
+	}
+
+	return chunks, q.Args
+}
+
+// AppendPlaceholder appends a "?" placeholder to the query's SQL and value to its arguments.
+// It returns q, you can chain method calls.
+func (q *Query) AppendPlaceholder(value interface{}) *Query {
+	return q.Append("?", value)
+}
+
+// AppendIn appends a "?" placeholder for each of the values to the query's SQL,
+// separated by commas, and the values to its arguments. It doesn't add the parentheses,
+// e.g. q.Append("WHERE id IN (").AppendIn(ids).Append(")")
+// It returns q, you can chain method calls.
+func (q *Query) AppendIn(values []interface{}) *Query {
+	return q.Append(Placeholders(len(values)), values...)
+}
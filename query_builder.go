@@ -0,0 +1,43 @@
+package hotcoal
+
+// QueryBuilder is used to efficiently build a parameterized query.
+// It's a Builder, which also collects the arguments of the placeholders,
+// so they don't get out of sync. The zero value is ready to use.
+// Do not copy a non-zero QueryBuilder.
+type QueryBuilder struct {
+	builder Builder
+	args    []interface{}
+}
+
+// WriteSQL appends sql to the query. It returns b, you can chain method calls.
+func (b *QueryBuilder) WriteSQL(sql hotcoalString) *QueryBuilder {
+	b.builder.Write(sql)
+
+	return b
+}
+
+// WriteParam appends a "?" placeholder to the query and value to its arguments.
+// It returns b, you can chain method calls.
+func (b *QueryBuilder) WriteParam(value interface{}) *QueryBuilder {
+	b.builder.Write("?")
+	b.args = append(b.args, value)
+
+	return b
+}
+
+// WriteQuery appends the SQL and the arguments of q to the query.
+// It returns b, you can chain method calls.
+func (b *QueryBuilder) WriteQuery(q *Query) *QueryBuilder {
+	b.builder.Write(q.SQL)
+	b.args = append(b.args, q.Args...)
+
+	return b
+}
+
+// Build returns the SQL as a plain string, along with the arguments,
+// so you can pass them to your SQL library, e.g. db.Query(b.Build())
+//
+//hotcoal:boundary
+func (b *QueryBuilder) Build() (string, []interface{}) {
+	return b.builder.String(), b.args
+}
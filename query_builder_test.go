@@ -0,0 +1,42 @@
+package hotcoal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	columns := Allowlist("first_name", "last_name")
+
+	var b QueryBuilder
+
+	b.WriteSQL("SELECT * FROM users WHERE deleted_at IS NULL")
+	b.WriteSQL(" AND ").WriteSQL(columns.MV("last_name")).WriteSQL(" = ").WriteParam("Smith")
+	b.WriteSQL(" AND age BETWEEN ").WriteParam(18).WriteSQL(" AND ").WriteParam(65)
+
+	var in Query
+	in.Append(" AND role IN (").AppendIn([]interface{}{"admin", "owner"}).Append(")")
+	b.WriteQuery(&in)
+
+	b.WriteSQL(" LIMIT 10")
+
+	sql, args := b.Build()
+
+	expected := "SELECT * FROM users WHERE deleted_at IS NULL AND last_name = ? AND age BETWEEN ? AND ? AND role IN (?, ?) LIMIT 10"
+	if expected != sql {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]interface{}{"Smith", 18, 65, "admin", "owner"}, args) {
+		t.Fail()
+	}
+}
+
+func TestQueryBuilderEmpty(t *testing.T) {
+	var b QueryBuilder
+
+	sql, args := b.Build()
+	if "" != sql || args != nil {
+		t.Fail()
+	}
+}
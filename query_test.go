@@ -0,0 +1,122 @@
+package hotcoal
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	var q Query
+
+	q.Append("SELECT * FROM users WHERE first_name = ?", "John").
+		Append(" AND age BETWEEN ? AND ?", 18, 65).
+		Append(" ORDER BY last_name")
+
+	sql, args := q.Build()
+
+	if "SELECT * FROM users WHERE first_name = ? AND age BETWEEN ? AND ? ORDER BY last_name" != sql {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]interface{}{"John", 18, 65}, args) {
+		t.Fail()
+	}
+}
+
+func TestQueryParts(t *testing.T) {
+	var q Query
+
+	q.Append("SELECT * FROM users WHERE first_name = ?", "John").
+		Append(" AND note <> '?' AND age BETWEEN ? AND ?", 18, 65).
+		Append(" ORDER BY last_name")
+
+	chunks, args := q.Parts()
+
+	expected := Slice{"SELECT * FROM users WHERE first_name = ", " AND note <> '?' AND age BETWEEN ", " AND ", " ORDER BY last_name"}
+	if !reflect.DeepEqual(expected, chunks) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]interface{}{"John", 18, 65}, args) {
+		t.Fail()
+	}
+
+	if len(args)+1 != len(chunks) {
+		t.Fail()
+	}
+
+	if q.SQL != chunks.Join("?") {
+		t.Fail()
+	}
+
+	chunks, args = (&Query{}).Parts()
+	if !reflect.DeepEqual(Slice{""}, chunks) || len(args) != 0 {
+		t.Fail()
+	}
+}
+
+func TestQueryEmpty(t *testing.T) {
+	sql, args := (&Query{}).Build()
+
+	if "" != sql || len(args) != 0 {
+		t.Fail()
+	}
+}
+
+func TestQueryAppendPlaceholder(t *testing.T) {
+	var q Query
+
+	q.Append("SELECT * FROM users WHERE first_name = ").
+		AppendPlaceholder("John").
+		Append(" AND last_name = ").
+		AppendPlaceholder("Doe")
+
+	sql, args := q.Build()
+
+	if "SELECT * FROM users WHERE first_name = ? AND last_name = ?" != sql {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]interface{}{"John", "Doe"}, args) {
+		t.Fail()
+	}
+}
+
+func TestQueryAppendIn(t *testing.T) {
+	var q Query
+
+	q.Append("SELECT * FROM users WHERE id IN (").
+		AppendIn([]interface{}{1, 2, 3}).
+		Append(") AND first_name = ").
+		AppendPlaceholder("John")
+
+	sql, args := q.Build()
+
+	if "SELECT * FROM users WHERE id IN (?, ?, ?) AND first_name = ?" != sql {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual([]interface{}{1, 2, 3, "John"}, args) {
+		t.Fail()
+	}
+}
+
+func TestQueryPlaceholderCount(t *testing.T) {
+	for n := 0; n < 10; n++ {
+		var q Query
+
+		values := make([]interface{}, n)
+		for i := range values {
+			values[i] = i
+		}
+
+		q.AppendIn(values).AppendPlaceholder(n).AppendIn(values)
+
+		sql, args := q.Build()
+
+		if strings.Count(sql, "?") != len(args) || len(args) != 2*n+1 {
+			t.Fail()
+		}
+	}
+}
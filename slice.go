@@ -0,0 +1,89 @@
+package hotcoal
+
+import "sort"
+
+// Map returns a new Slice with fn applied to each element of s.
+// The Slice s is not modified.
+//
+// You can chain method calls.
+func (s Slice) Map(fn func(hotcoalString) hotcoalString) Slice {
+	ret := make(Slice, 0, len(s))
+	for _, el := range s {
+		ret = append(ret, fn(el))
+	}
+
+	return ret
+}
+
+// Join concatenates the elements of s to create a single hotcoalString. The separator
+// hotcoalString sep is placed between elements in the resulting hotcoalString.
+//
+// It's the same as the Join function, but you can chain method calls,
+// e.g. columns.Map(fn).Join(", ")
+func (s Slice) Join(sep hotcoalString) hotcoalString {
+	return Join(s, sep)
+}
+
+// Contains reports whether target is an element of s.
+func (s Slice) Contains(target hotcoalString) bool {
+	return s.Index(target) != -1
+}
+
+// Index returns the index of the first occurrence of target in s,
+// or -1 if target is not present in s.
+func (s Slice) Index(target hotcoalString) int {
+	for i, el := range s {
+		if el == target {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Dedup returns a new Slice with the duplicate elements of s removed,
+// keeping the first occurrence of each element in its original order.
+// The Slice s is not modified.
+//
+// You can chain method calls.
+func (s Slice) Dedup() Slice {
+	seen := make(map[hotcoalString]unitT, len(s))
+	ret := make(Slice, 0, len(s))
+
+	for _, el := range s {
+		if _, ok := seen[el]; ok {
+			continue
+		}
+
+		seen[el] = unit
+		ret = append(ret, el)
+	}
+
+	return ret
+}
+
+// Sort returns a new Slice with the elements of s sorted in increasing order.
+// The Slice s is not modified.
+//
+// You can chain method calls.
+func (s Slice) Sort() Slice {
+	return s.SortFunc(func(a, b hotcoalString) bool {
+		return a < b
+	})
+}
+
+// SortFunc returns a new Slice with the elements of s sorted using the less function.
+// The sort is stable, equal elements keep their original order.
+// The Slice s is not modified.
+//
+// You can chain method calls.
+func (s Slice) SortFunc(less func(a, b hotcoalString) bool) Slice {
+	ret := make(Slice, len(s))
+	copy(ret, s)
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return less(ret[i], ret[j])
+	})
+
+	return ret
+}
@@ -0,0 +1,130 @@
+package hotcoal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSliceMap(t *testing.T) {
+	columns := Slice{"first_name", "last_name"}
+
+	assignments := columns.Map(func(column hotcoalString) hotcoalString {
+		return column + " = ?"
+	})
+
+	if !reflect.DeepEqual(Slice{"first_name = ?", "last_name = ?"}, assignments) {
+		t.Fail()
+	}
+
+	if "first_name = ?, last_name = ?" != Join(assignments, ", ").String() {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"first_name", "last_name"}, columns) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{}, Slice{}.Map(ToUpper)) {
+		t.Fail()
+	}
+}
+
+func TestSliceJoin(t *testing.T) {
+	if "foo-bar-tar" != (Slice{"foo", "bar", "tar"}).Join("-").String() {
+		t.Fail()
+	}
+
+	if "" != (Slice{}).Join("-").String() {
+		t.Fail()
+	}
+
+	columns := Slice{"first_name", "last_name"}
+
+	sql := columns.Map(func(column hotcoalString) hotcoalString {
+		return column + " = ?"
+	}).Join(", ")
+
+	if "first_name = ?, last_name = ?" != sql.String() {
+		t.Fail()
+	}
+}
+
+func TestSliceContains(t *testing.T) {
+	s := Slice{"foo", "bar", "foo"}
+
+	if !s.Contains("foo") || !s.Contains("bar") || s.Contains("tar") || s.Contains("") {
+		t.Fail()
+	}
+
+	if (Slice{}).Contains("foo") || Slice(nil).Contains("") {
+		t.Fail()
+	}
+}
+
+func TestSliceIndex(t *testing.T) {
+	s := Slice{"foo", "bar", "foo", "bar"}
+
+	if s.Index("foo") != 0 || s.Index("bar") != 1 || s.Index("tar") != -1 {
+		t.Fail()
+	}
+
+	if (Slice{}).Index("foo") != -1 || Slice(nil).Index("") != -1 {
+		t.Fail()
+	}
+}
+
+func TestSliceDedup(t *testing.T) {
+	s := Slice{"last_name", "id", "first_name", "id", "last_name"}
+
+	if !reflect.DeepEqual(Slice{"last_name", "id", "first_name"}, s.Dedup()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"last_name", "id", "first_name", "id", "last_name"}, s) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"id"}, (Slice{"id", "id", "id"}).Dedup()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"c", "b", "a"}, (Slice{"c", "b", "a"}).Dedup()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{}, (Slice{}).Dedup()) {
+		t.Fail()
+	}
+}
+
+func TestSliceSort(t *testing.T) {
+	s := Slice{"last_name", "id", "first_name", "Name"}
+
+	if !reflect.DeepEqual(Slice{"Name", "first_name", "id", "last_name"}, s.Sort()) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"last_name", "id", "first_name", "Name"}, s) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{}, (Slice{}).Sort()) {
+		t.Fail()
+	}
+}
+
+func TestSliceSortFunc(t *testing.T) {
+	s := Slice{"last_name", "id", "first_name", "name"}
+
+	byLength := s.SortFunc(func(a, b hotcoalString) bool {
+		return len(a) < len(b)
+	})
+
+	if !reflect.DeepEqual(Slice{"id", "name", "last_name", "first_name"}, byLength) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"last_name", "id", "first_name", "name"}, s) {
+		t.Fail()
+	}
+}
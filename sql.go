@@ -0,0 +1,34 @@
+package hotcoal
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Execer is the interface used by Query.ExecContext.
+// It is implemented by *sql.DB, *sql.Tx and *sql.Conn
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// Queryer is the interface used by Query.QueryContext.
+// It is implemented by *sql.DB, *sql.Tx and *sql.Conn
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// ExecContext executes the query with its arguments, using db,
+// which can be a *sql.DB, *sql.Tx or *sql.Conn
+func (q *Query) ExecContext(ctx context.Context, db Execer) (sql.Result, error) {
+	query, args := q.Build()
+
+	return db.ExecContext(ctx, query, args...)
+}
+
+// QueryContext executes the query with its arguments and returns the rows, using db,
+// which can be a *sql.DB, *sql.Tx or *sql.Conn
+func (q *Query) QueryContext(ctx context.Context, db Queryer) (*sql.Rows, error) {
+	query, args := q.Build()
+
+	return db.QueryContext(ctx, query, args...)
+}
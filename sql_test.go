@@ -0,0 +1,71 @@
+package hotcoal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ Execer  = &sql.DB{}
+	_ Execer  = &sql.Tx{}
+	_ Execer  = &sql.Conn{}
+	_ Queryer = &sql.DB{}
+	_ Queryer = &sql.Tx{}
+	_ Queryer = &sql.Conn{}
+)
+
+type fakeDB struct {
+	query string
+	args  []interface{}
+}
+
+func (db *fakeDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	db.query = query
+	db.args = args
+
+	return nil, errors.New("exec")
+}
+
+func (db *fakeDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	db.query = query
+	db.args = args
+
+	return nil, errors.New("query")
+}
+
+func TestQueryExecContext(t *testing.T) {
+	var q Query
+	db := &fakeDB{}
+
+	q.Append("DELETE FROM users WHERE id = ").AppendPlaceholder(1)
+
+	_, err := q.ExecContext(context.Background(), db)
+
+	if err == nil || "exec" != err.Error() {
+		t.Fail()
+	}
+
+	if "DELETE FROM users WHERE id = ?" != db.query || !reflect.DeepEqual([]interface{}{1}, db.args) {
+		t.Fail()
+	}
+}
+
+func TestQueryQueryContext(t *testing.T) {
+	var q Query
+	db := &fakeDB{}
+
+	q.Append("SELECT * FROM users WHERE id = ").AppendPlaceholder(1)
+
+	_, err := q.QueryContext(context.Background(), db)
+
+	if err == nil || "query" != err.Error() {
+		t.Fail()
+	}
+
+	if "SELECT * FROM users WHERE id = ?" != db.query || !reflect.DeepEqual([]interface{}{1}, db.args) {
+		t.Fail()
+	}
+}
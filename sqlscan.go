@@ -0,0 +1,148 @@
+package hotcoal
+
+import "strings"
+
+// sqlSegmentKind is the kind of a segment of SQL
+type sqlSegmentKind int
+
+const (
+	sqlCode sqlSegmentKind = iota
+	sqlQuoted
+	sqlLineComment
+	sqlBlockComment
+)
+
+// sqlSegment is a segment of SQL, see splitSQL
+type sqlSegment struct {
+	kind sqlSegmentKind
+	text string
+}
+
+// splitSQL splits the SQL into segments of code, quoted strings, and comments.
+// Quoted strings are string literals 'foo', quoted identifiers "foo" and `foo`,
+// and PostgreSQL dollar-quoted strings $$foo$$ and $tag$foo$tag$.
+// Quotes are escaped by doubling them, backslash escapes are not supported.
+// Line comments -- end before the newline, block comments /* */ don't nest.
+// Unterminated quoted strings and comments extend to the end of the SQL.
+//
+// Concatenating the texts of the segments returns the SQL.
+func splitSQL(sql string) []sqlSegment {
+	var ret []sqlSegment
+
+	codeStart := 0
+
+	for i := 0; i < len(sql); {
+		var kind sqlSegmentKind
+		var end int
+
+		switch {
+		case sql[i] == '\'' || sql[i] == '"' || sql[i] == '`':
+			kind, end = sqlQuoted, quotedEnd(sql, i)
+		case strings.HasPrefix(sql[i:], "--"):
+			kind, end = sqlLineComment, lineCommentEnd(sql, i)
+		case strings.HasPrefix(sql[i:], "/*"):
+			kind, end = sqlBlockComment, blockCommentEnd(sql, i)
+		case sql[i] == '$':
+			var ok bool
+			if end, ok = dollarQuotedEnd(sql, i); !ok {
+				i++
+				continue
+			}
+			kind = sqlQuoted
+		default:
+			i++
+			continue
+		}
+
+		if codeStart < i {
+			ret = append(ret, sqlSegment{kind: sqlCode, text: sql[codeStart:i]})
+		}
+
+		ret = append(ret, sqlSegment{kind: kind, text: sql[i:end]})
+		i = end
+		codeStart = end
+	}
+
+	if codeStart < len(sql) {
+		ret = append(ret, sqlSegment{kind: sqlCode, text: sql[codeStart:]})
+	}
+
+	return ret
+}
+
+// quotedEnd returns the end of the quoted string starting at start
+func quotedEnd(sql string, start int) int {
+	quote := sql[start]
+
+	for i := start + 1; i < len(sql); i++ {
+		if sql[i] != quote {
+			continue
+		}
+
+		if i+1 < len(sql) && sql[i+1] == quote {
+			// doubled quote
+			i++
+			continue
+		}
+
+		return i + 1
+	}
+
+	return len(sql)
+}
+
+// lineCommentEnd returns the end of the line comment starting at start, excluding the newline
+func lineCommentEnd(sql string, start int) int {
+	if i := strings.IndexByte(sql[start:], '\n'); i != -1 {
+		return start + i
+	}
+
+	return len(sql)
+}
+
+// blockCommentEnd returns the end of the block comment starting at start
+func blockCommentEnd(sql string, start int) int {
+	if i := strings.Index(sql[start+2:], "*/"); i != -1 {
+		return start + 2 + i + 2
+	}
+
+	return len(sql)
+}
+
+// dollarQuotedEnd returns the end of the PostgreSQL dollar-quoted string starting at start,
+// and false if there is no dollar-quoted string starting at start, e.g. it's a $1 placeholder.
+func dollarQuotedEnd(sql string, start int) (int, bool) {
+	if start > 0 && isIdentifierByte(sql[start-1]) {
+		// e.g. price$
+		return 0, false
+	}
+
+	i := start + 1
+	for i < len(sql) && sql[i] != '$' {
+		c := sql[i]
+		if !isIdentifierByte(c) || (i == start+1 && '0' <= c && c <= '9') {
+			return 0, false
+		}
+
+		i++
+	}
+
+	if i == len(sql) {
+		return 0, false
+	}
+
+	tag := sql[start : i+1]
+
+	if j := strings.Index(sql[i+1:], tag); j != -1 {
+		return i + 1 + j + len(tag), true
+	}
+
+	return len(sql), true
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		('a' <= c && c <= 'z') ||
+		('A' <= c && c <= 'Z') ||
+		('0' <= c && c <= '9')
+}
@@ -0,0 +1,52 @@
+package hotcoal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitSQL(t *testing.T) {
+	sql := "SELECT 'it''s ?', \"a?\", `b?` -- c?\nFROM t /* d? */ WHERE e = ? AND f = $$g?$$ AND h = $x$i?$x$ AND price$ = $1"
+
+	expected := []sqlSegment{
+		{sqlCode, "SELECT "},
+		{sqlQuoted, "'it''s ?'"},
+		{sqlCode, ", "},
+		{sqlQuoted, "\"a?\""},
+		{sqlCode, ", "},
+		{sqlQuoted, "`b?`"},
+		{sqlCode, " "},
+		{sqlLineComment, "-- c?"},
+		{sqlCode, "\nFROM t "},
+		{sqlBlockComment, "/* d? */"},
+		{sqlCode, " WHERE e = ? AND f = "},
+		{sqlQuoted, "$$g?$$"},
+		{sqlCode, " AND h = "},
+		{sqlQuoted, "$x$i?$x$"},
+		{sqlCode, " AND price$ = $1"},
+	}
+
+	if segments := splitSQL(sql); !reflect.DeepEqual(expected, segments) {
+		t.Errorf("%#v", segments)
+	}
+}
+
+func TestSplitSQLUnterminated(t *testing.T) {
+	for sql, expected := range map[string][]sqlSegment{
+		"":              nil,
+		"SELECT 1":      {{sqlCode, "SELECT 1"}},
+		"a 'b":          {{sqlCode, "a "}, {sqlQuoted, "'b"}},
+		"a 'b''":        {{sqlCode, "a "}, {sqlQuoted, "'b''"}},
+		"a -- b":        {{sqlCode, "a "}, {sqlLineComment, "-- b"}},
+		"a /* b":        {{sqlCode, "a "}, {sqlBlockComment, "/* b"}},
+		"a /*/ b":       {{sqlCode, "a "}, {sqlBlockComment, "/*/ b"}},
+		"a $$ b":        {{sqlCode, "a "}, {sqlQuoted, "$$ b"}},
+		"a $b":          {{sqlCode, "a $b"}},
+		"a - b / c":     {{sqlCode, "a - b / c"}},
+		"'a'/*b*/--c\n": {{sqlQuoted, "'a'"}, {sqlBlockComment, "/*b*/"}, {sqlLineComment, "--c"}, {sqlCode, "\n"}},
+	} {
+		if segments := splitSQL(sql); !reflect.DeepEqual(expected, segments) {
+			t.Errorf("%q: %#v", sql, segments)
+		}
+	}
+}
@@ -1,8 +1,89 @@
 package hotcoal
 
-import "strconv"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidInt is returned by ValidateInt, when the value is not an integer.
+// You can check for it using errors.Is
+var ErrInvalidInt = errors.New("Hotcoal validation error - value is not a valid integer")
+
+// ErrIntOutOfRange is returned by ValidateIntRange, when the value is an integer, but it's out of range.
+// You can check for it using errors.Is
+var ErrIntOutOfRange = errors.New("Hotcoal validation error - value is out of range")
 
 // The Itoa function converts an int to a hotcoalString.
 func Itoa(i int) hotcoalString {
 	return hotcoalString(strconv.Itoa(i))
 }
+
+// The FormatInt function converts an int64 to a decimal hotcoalString.
+// You can use it with any signed integer type, e.g. FormatInt(int64(i)) for an int32.
+//
+// Under the hood, it uses strconv.FormatInt https://pkg.go.dev/strconv#FormatInt
+func FormatInt(i int64) hotcoalString {
+	return hotcoalString(strconv.FormatInt(i, 10))
+}
+
+// The FormatUint function converts a uint64 to a decimal hotcoalString.
+// You can use it with any unsigned integer type, e.g. FormatUint(uint64(u)) for a uint.
+//
+// Under the hood, it uses strconv.FormatUint https://pkg.go.dev/strconv#FormatUint
+func FormatUint(u uint64) hotcoalString {
+	return hotcoalString(strconv.FormatUint(u, 10))
+}
+
+// The ValidateInt function parses a string variable as a decimal integer, e.g. a LIMIT from a query parameter,
+// and returns it as a hotcoalString. Surrounding whitespace is ignored.
+// The returned hotcoalString is formatted from the parsed int, never the value itself,
+// e.g. "007" returns "7", so it contains only digits and an optional minus sign.
+// If the value is not an integer, it returns an error, which wraps ErrInvalidInt.
+//
+// Under the hood, it uses strconv.Atoi https://pkg.go.dev/strconv#Atoi
+//
+//hotcoal:boundary
+func ValidateInt(value string) (hotcoalString, error) {
+	i, err := parseInt(value)
+	if err != nil {
+		return "", err
+	}
+
+	return Itoa(i), nil
+}
+
+// The ValidateIntRange function is like ValidateInt, but it also checks that min <= value <= max,
+// e.g. ValidateIntRange(pageSize, 1, 100).
+// If the value is not an integer, it returns an error, which wraps ErrInvalidInt.
+// If it's out of range, it returns an error, which wraps ErrIntOutOfRange.
+// If min is greater than max, ValidateIntRange panics.
+//
+//hotcoal:boundary
+func ValidateIntRange(value string, min, max int) (hotcoalString, error) {
+	if min > max {
+		panic("Hotcoal ValidateIntRange received min greater than max")
+	}
+
+	i, err := parseInt(value)
+	if err != nil {
+		return "", err
+	}
+
+	if i < min || i > max {
+		return "", fmt.Errorf("%w %#v, it is not between %d and %d", ErrIntOutOfRange, value, min, max)
+	}
+
+	return Itoa(i), nil
+}
+
+// parseInt parses a decimal integer, ignoring surrounding whitespace
+func parseInt(value string) (int, error) {
+	i, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%w %#v", ErrInvalidInt, value)
+	}
+
+	return i, nil
+}
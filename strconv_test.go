@@ -1,9 +1,121 @@
 package hotcoal
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"testing"
+)
 
 func TestItoa(t *testing.T) {
 	if "123" != Itoa(123).String() {
 		t.Fail()
 	}
 }
+
+func TestFormatInt(t *testing.T) {
+	for expected, i := range map[string]int64{
+		"0":                    0,
+		"-1":                   -1,
+		"-128":                 math.MinInt8,
+		"127":                  math.MaxInt8,
+		"-32768":               math.MinInt16,
+		"2147483647":           math.MaxInt32,
+		"-2147483648":          int64(int32(math.MinInt32)),
+		"-9223372036854775808": math.MinInt64,
+		"9223372036854775807":  math.MaxInt64,
+	} {
+		if expected != FormatInt(i).String() {
+			t.Error(expected)
+		}
+	}
+}
+
+func TestFormatUint(t *testing.T) {
+	for expected, u := range map[string]uint64{
+		"0":                    0,
+		"255":                  math.MaxUint8,
+		"65535":                uint64(uint16(math.MaxUint16)),
+		"4294967295":           math.MaxUint32,
+		"18446744073709551615": math.MaxUint64,
+	} {
+		if expected != FormatUint(u).String() {
+			t.Error(expected)
+		}
+	}
+}
+
+func TestValidateInt(t *testing.T) {
+	for value, expected := range map[string]string{
+		"0":     "0",
+		"7":     "7",
+		"007":   "7",
+		"-42":   "-42",
+		"+42":   "42",
+		" 10\n": "10",
+	} {
+		hs, err := ValidateInt(value)
+		if expected != hs.String() || err != nil {
+			t.Error(value)
+		}
+	}
+
+	for _, value := range []string{"", " ", "1; DROP TABLE users", "1 OR 1=1", "1.5", "0x10", "1e3", "--1", "99999999999999999999"} {
+		hs, err := ValidateInt(value)
+		if "" != hs.String() || !errors.Is(err, ErrInvalidInt) {
+			t.Error(value)
+		}
+	}
+
+	_, err := ValidateInt("1; DROP")
+	if `Hotcoal validation error - value is not a valid integer "1; DROP"` != err.Error() {
+		t.Fail()
+	}
+}
+
+func TestValidateIntRange(t *testing.T) {
+	for value, expected := range map[string]string{
+		"1":   "1",
+		"50":  "50",
+		"100": "100",
+		"001": "1",
+	} {
+		hs, err := ValidateIntRange(value, 1, 100)
+		if expected != hs.String() || err != nil {
+			t.Error(value)
+		}
+	}
+
+	for _, value := range []string{"0", "101", "-1", "99999999999"} {
+		hs, err := ValidateIntRange(value, 1, 100)
+		if "" != hs.String() || !errors.Is(err, ErrIntOutOfRange) || errors.Is(err, ErrInvalidInt) {
+			t.Error(value)
+		}
+	}
+
+	for _, value := range []string{"1; DROP", "", "1.5"} {
+		hs, err := ValidateIntRange(value, 1, 100)
+		if "" != hs.String() || !errors.Is(err, ErrInvalidInt) || errors.Is(err, ErrIntOutOfRange) {
+			t.Error(value)
+		}
+	}
+
+	_, err := ValidateIntRange("101", 1, 100)
+	if `Hotcoal validation error - value is out of range "101", it is not between 1 and 100` != err.Error() {
+		t.Fail()
+	}
+
+	hs, err := ValidateIntRange("5", 5, 5)
+	if "5" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestValidateIntRangePanic(t *testing.T) {
+	defer func() {
+		if "Hotcoal ValidateIntRange received min greater than max" != recover() {
+			t.Fail()
+		}
+	}()
+
+	ValidateIntRange("1", 100, 1)
+}
@@ -1,22 +1,38 @@
 package hotcoal
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+)
 
 // Join concatenates the elements of its first argument to create a single hotcoalString. The separator
 // hotcoalString sep is placed between elements in the resulting hotcoalString.
 //
-// Under the hood, it uses strings.Join https://pkg.go.dev/strings#Join
+// It works like strings.Join https://pkg.go.dev/strings#Join
+// but writes the elements directly into a buffer of the exact size.
 func Join(elems []hotcoalString, sep hotcoalString) hotcoalString {
-	stringElems := make([]string, 0, len(elems))
+	switch len(elems) {
+	case 0:
+		return ""
+	case 1:
+		return elems[0]
+	}
+
+	n := len(sep) * (len(elems) - 1)
 	for _, el := range elems {
-		stringElems = append(stringElems, string(el))
+		n += len(el)
 	}
 
-	stringSep := string(sep)
+	var b strings.Builder
+	b.Grow(n)
 
-	result := strings.Join(stringElems, stringSep)
+	b.WriteString(string(elems[0]))
+	for _, el := range elems[1:] {
+		b.WriteString(string(sep))
+		b.WriteString(string(el))
+	}
 
-	return hotcoalString(result)
+	return hotcoalString(b.String())
 }
 
 // Replace returns a copy of the hotcoalString s with the first n
@@ -58,3 +74,507 @@ func (s hotcoalString) ReplaceAll(old, new hotcoalString) hotcoalString {
 
 	return hotcoalString(result)
 }
+
+// Split slices s into all substrings separated by sep and returns a Slice of
+// the substrings between those separators.
+//
+// If s does not contain sep and sep is not empty, Split returns a
+// Slice of length 1 whose only element is s.
+//
+// If sep is empty, Split splits after each UTF-8 sequence. If both s
+// and sep are empty, Split returns an empty Slice.
+//
+// Under the hood, it uses strings.Split https://pkg.go.dev/strings#Split
+func Split(s, sep hotcoalString) Slice {
+	return s.Split(sep)
+}
+
+// Split slices s into all substrings separated by sep, see the Split function.
+//
+// Under the hood, it uses strings.Split https://pkg.go.dev/strings#Split
+func (s hotcoalString) Split(sep hotcoalString) Slice {
+	result := strings.Split(
+		string(s),
+		string(sep),
+	)
+
+	return toSlice(result)
+}
+
+// SplitN slices s into substrings separated by sep and returns a Slice of
+// the substrings between those separators.
+//
+// The count determines the number of substrings to return:
+//
+//	n > 0: at most n substrings; the last substring will be the unsplit remainder.
+//	n == 0: the result is nil (zero substrings)
+//	n < 0: all substrings
+//
+// Under the hood, it uses strings.SplitN https://pkg.go.dev/strings#SplitN
+func (s hotcoalString) SplitN(sep hotcoalString, n int) Slice {
+	result := strings.SplitN(
+		string(s),
+		string(sep),
+		n,
+	)
+
+	return toSlice(result)
+}
+
+// Fields splits the hotcoalString s around each instance of one or more consecutive white space
+// characters, as defined by unicode.IsSpace, returning a Slice of substrings of s or an
+// empty Slice if s contains only white space.
+//
+// You can join the result using a single space, to normalize a multi-line SQL constant.
+//
+// Under the hood, it uses strings.Fields https://pkg.go.dev/strings#Fields
+func (s hotcoalString) Fields() Slice {
+	result := strings.Fields(string(s))
+
+	return toSlice(result)
+}
+
+// TrimSpace returns a slice of the hotcoalString s, with all leading
+// and trailing white space removed, as defined by Unicode.
+//
+// Under the hood, it uses strings.TrimSpace https://pkg.go.dev/strings#TrimSpace
+func TrimSpace(s hotcoalString) hotcoalString {
+	return s.TrimSpace()
+}
+
+// TrimSpace returns a slice of the hotcoalString s, with all leading
+// and trailing white space removed, as defined by Unicode.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.TrimSpace https://pkg.go.dev/strings#TrimSpace
+func (s hotcoalString) TrimSpace() hotcoalString {
+	result := strings.TrimSpace(string(s))
+
+	return hotcoalString(result)
+}
+
+// Trim returns a slice of the hotcoalString s with all leading and
+// trailing Unicode code points contained in cutset removed.
+//
+// Under the hood, it uses strings.Trim https://pkg.go.dev/strings#Trim
+func Trim(s, cutset hotcoalString) hotcoalString {
+	return s.Trim(cutset)
+}
+
+// Trim returns a slice of the hotcoalString s with all leading and
+// trailing Unicode code points contained in cutset removed.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.Trim https://pkg.go.dev/strings#Trim
+func (s hotcoalString) Trim(cutset hotcoalString) hotcoalString {
+	result := strings.Trim(
+		string(s),
+		string(cutset),
+	)
+
+	return hotcoalString(result)
+}
+
+// TrimPrefix returns s without the provided leading prefix hotcoalString.
+// If s doesn't start with prefix, s is returned unchanged.
+//
+// Under the hood, it uses strings.TrimPrefix https://pkg.go.dev/strings#TrimPrefix
+func TrimPrefix(s, prefix hotcoalString) hotcoalString {
+	return s.TrimPrefix(prefix)
+}
+
+// TrimPrefix returns s without the provided leading prefix hotcoalString.
+// If s doesn't start with prefix, s is returned unchanged.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.TrimPrefix https://pkg.go.dev/strings#TrimPrefix
+func (s hotcoalString) TrimPrefix(prefix hotcoalString) hotcoalString {
+	result := strings.TrimPrefix(
+		string(s),
+		string(prefix),
+	)
+
+	return hotcoalString(result)
+}
+
+// TrimSuffix returns s without the provided trailing suffix hotcoalString.
+// If s doesn't end with suffix, s is returned unchanged.
+//
+// Under the hood, it uses strings.TrimSuffix https://pkg.go.dev/strings#TrimSuffix
+func TrimSuffix(s, suffix hotcoalString) hotcoalString {
+	return s.TrimSuffix(suffix)
+}
+
+// TrimSuffix returns s without the provided trailing suffix hotcoalString.
+// If s doesn't end with suffix, s is returned unchanged.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.TrimSuffix https://pkg.go.dev/strings#TrimSuffix
+func (s hotcoalString) TrimSuffix(suffix hotcoalString) hotcoalString {
+	result := strings.TrimSuffix(
+		string(s),
+		string(suffix),
+	)
+
+	return hotcoalString(result)
+}
+
+// ToUpper returns s with all Unicode letters mapped to their upper case.
+//
+// Under the hood, it uses strings.ToUpper https://pkg.go.dev/strings#ToUpper
+func ToUpper(s hotcoalString) hotcoalString {
+	return s.ToUpper()
+}
+
+// ToUpper returns s with all Unicode letters mapped to their upper case.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.ToUpper https://pkg.go.dev/strings#ToUpper
+func (s hotcoalString) ToUpper() hotcoalString {
+	result := strings.ToUpper(string(s))
+
+	return hotcoalString(result)
+}
+
+// ToLower returns s with all Unicode letters mapped to their lower case.
+//
+// Under the hood, it uses strings.ToLower https://pkg.go.dev/strings#ToLower
+func ToLower(s hotcoalString) hotcoalString {
+	return s.ToLower()
+}
+
+// ToLower returns s with all Unicode letters mapped to their lower case.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.ToLower https://pkg.go.dev/strings#ToLower
+func (s hotcoalString) ToLower() hotcoalString {
+	result := strings.ToLower(string(s))
+
+	return hotcoalString(result)
+}
+
+// ToUpperSpecial returns s with all Unicode letters mapped to their
+// upper case using the case mapping specified by c, e.g. unicode.TurkishCase
+//
+// Under the hood, it uses strings.ToUpperSpecial https://pkg.go.dev/strings#ToUpperSpecial
+func ToUpperSpecial(c unicode.SpecialCase, s hotcoalString) hotcoalString {
+	return s.ToUpperSpecial(c)
+}
+
+// ToUpperSpecial returns s with all Unicode letters mapped to their
+// upper case using the case mapping specified by c, e.g. unicode.TurkishCase
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.ToUpperSpecial https://pkg.go.dev/strings#ToUpperSpecial
+func (s hotcoalString) ToUpperSpecial(c unicode.SpecialCase) hotcoalString {
+	result := strings.ToUpperSpecial(c, string(s))
+
+	return hotcoalString(result)
+}
+
+// ToLowerSpecial returns s with all Unicode letters mapped to their
+// lower case using the case mapping specified by c, e.g. unicode.TurkishCase
+//
+// Under the hood, it uses strings.ToLowerSpecial https://pkg.go.dev/strings#ToLowerSpecial
+func ToLowerSpecial(c unicode.SpecialCase, s hotcoalString) hotcoalString {
+	return s.ToLowerSpecial(c)
+}
+
+// ToLowerSpecial returns s with all Unicode letters mapped to their
+// lower case using the case mapping specified by c, e.g. unicode.TurkishCase
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.ToLowerSpecial https://pkg.go.dev/strings#ToLowerSpecial
+func (s hotcoalString) ToLowerSpecial(c unicode.SpecialCase) hotcoalString {
+	result := strings.ToLowerSpecial(c, string(s))
+
+	return hotcoalString(result)
+}
+
+// Title returns s with the first letter of each word mapped to its title case,
+// e.g. "first_name" becomes "First_Name". A word starts after any character
+// which is not a letter or a digit. The other letters are not modified.
+//
+// You can chain method calls.
+//
+// Unlike the deprecated strings.Title https://pkg.go.dev/strings#Title
+// it treats underscores as word boundaries, which suits column names.
+func (s hotcoalString) Title() hotcoalString {
+	return s.TitleSpecial(nil)
+}
+
+// TitleSpecial is like Title, but it uses the case mapping specified by c,
+// e.g. unicode.TurkishCase
+//
+// You can chain method calls.
+func (s hotcoalString) TitleSpecial(c unicode.SpecialCase) hotcoalString {
+	atWordStart := true
+
+	mapping := func(r rune) rune {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		toTitle := atWordStart && isWordRune
+		atWordStart = !isWordRune
+
+		switch {
+		case !toTitle:
+			return r
+		case c != nil:
+			return c.ToTitle(r)
+		default:
+			return unicode.ToTitle(r)
+		}
+	}
+
+	return s.Map(mapping)
+}
+
+// HasPrefix reports whether the hotcoalString s begins with prefix.
+//
+// Under the hood, it uses strings.HasPrefix https://pkg.go.dev/strings#HasPrefix
+func (s hotcoalString) HasPrefix(prefix hotcoalString) bool {
+	return strings.HasPrefix(string(s), string(prefix))
+}
+
+// HasSuffix reports whether the hotcoalString s ends with suffix.
+//
+// Under the hood, it uses strings.HasSuffix https://pkg.go.dev/strings#HasSuffix
+func (s hotcoalString) HasSuffix(suffix hotcoalString) bool {
+	return strings.HasSuffix(string(s), string(suffix))
+}
+
+// Contains reports whether substr is within s.
+//
+// Under the hood, it uses strings.Contains https://pkg.go.dev/strings#Contains
+func (s hotcoalString) Contains(substr hotcoalString) bool {
+	return strings.Contains(string(s), string(substr))
+}
+
+// Repeat returns a new hotcoalString consisting of count copies of the hotcoalString s.
+//
+// It panics if count is negative or if the result of (len(s) * count) overflows.
+//
+// Under the hood, it uses strings.Repeat https://pkg.go.dev/strings#Repeat
+func Repeat(s hotcoalString, count int) hotcoalString {
+	return s.Repeat(count)
+}
+
+// Repeat returns a new hotcoalString consisting of count copies of the hotcoalString s.
+//
+// It panics if count is negative or if the result of (len(s) * count) overflows.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.Repeat https://pkg.go.dev/strings#Repeat
+func (s hotcoalString) Repeat(count int) hotcoalString {
+	result := strings.Repeat(string(s), count)
+
+	return hotcoalString(result)
+}
+
+// Equal reports whether s and other are the same hotcoalString.
+// It's the same as s == other, and reads better when chaining method calls.
+func (s hotcoalString) Equal(other hotcoalString) bool {
+	return s == other
+}
+
+// EqualFold reports whether s and t, interpreted as UTF-8 strings,
+// are equal under simple Unicode case-folding, which is a more general
+// form of case-insensitivity.
+//
+// Under the hood, it uses strings.EqualFold https://pkg.go.dev/strings#EqualFold
+func EqualFold(s, t hotcoalString) bool {
+	return s.EqualFold(t)
+}
+
+// EqualFold reports whether s and other, interpreted as UTF-8 strings,
+// are equal under simple Unicode case-folding, which is a more general
+// form of case-insensitivity.
+//
+// Under the hood, it uses strings.EqualFold https://pkg.go.dev/strings#EqualFold
+func (s hotcoalString) EqualFold(other hotcoalString) bool {
+	return strings.EqualFold(string(s), string(other))
+}
+
+// Compare returns an integer comparing two hotcoalStrings lexicographically.
+// The result will be 0 if s == other, -1 if s < other, and +1 if s > other.
+//
+// Under the hood, it uses strings.Compare https://pkg.go.dev/strings#Compare
+func (s hotcoalString) Compare(other hotcoalString) int {
+	return strings.Compare(string(s), string(other))
+}
+
+// Count counts the number of non-overlapping instances of substr in s.
+// If substr is empty, Count returns 1 + the number of Unicode code points in s.
+//
+// Under the hood, it uses strings.Count https://pkg.go.dev/strings#Count
+func Count(s, substr hotcoalString) int {
+	return s.Count(substr)
+}
+
+// Count counts the number of non-overlapping instances of substr in s.
+// If substr is empty, Count returns 1 + the number of Unicode code points in s.
+//
+// Under the hood, it uses strings.Count https://pkg.go.dev/strings#Count
+func (s hotcoalString) Count(substr hotcoalString) int {
+	return strings.Count(string(s), string(substr))
+}
+
+// Index returns the index of the first instance of substr in s, or -1 if substr is not present in s.
+//
+// Under the hood, it uses strings.Index https://pkg.go.dev/strings#Index
+func Index(s, substr hotcoalString) int {
+	return s.Index(substr)
+}
+
+// Index returns the index of the first instance of substr in s, or -1 if substr is not present in s.
+//
+// Under the hood, it uses strings.Index https://pkg.go.dev/strings#Index
+func (s hotcoalString) Index(substr hotcoalString) int {
+	return strings.Index(string(s), string(substr))
+}
+
+// Map returns a copy of the hotcoalString s with all its characters modified
+// according to the mapping function. If mapping returns a negative value,
+// the character is dropped from the hotcoalString with no replacement.
+//
+// You can chain method calls.
+//
+// Under the hood, it uses strings.Map https://pkg.go.dev/strings#Map
+func (s hotcoalString) Map(mapping func(rune) rune) hotcoalString {
+	result := strings.Map(mapping, string(s))
+
+	return hotcoalString(result)
+}
+
+// Prefix returns p followed by s. It's the same as p + s,
+// and reads better when chaining method calls or mapping a Slice.
+//
+// You can chain method calls.
+func (s hotcoalString) Prefix(p hotcoalString) hotcoalString {
+	return p + s
+}
+
+// Suffix returns s followed by suf. It's the same as s + suf,
+// and reads better when chaining method calls or mapping a Slice,
+// e.g. columns.Map(func(c hotcoalString) hotcoalString { return c.Suffix(" = ?") })
+//
+// You can chain method calls.
+func (s hotcoalString) Suffix(suf hotcoalString) hotcoalString {
+	return s + suf
+}
+
+// LastIndex returns the index of the last instance of substr in s, or -1 if substr is not present in s.
+//
+// Under the hood, it uses strings.LastIndex https://pkg.go.dev/strings#LastIndex
+func LastIndex(s, substr hotcoalString) int {
+	return s.LastIndex(substr)
+}
+
+// LastIndex returns the index of the last instance of substr in s, or -1 if substr is not present in s.
+//
+// Under the hood, it uses strings.LastIndex https://pkg.go.dev/strings#LastIndex
+func (s hotcoalString) LastIndex(substr hotcoalString) int {
+	return strings.LastIndex(string(s), string(substr))
+}
+
+// IndexAny returns the index of the first instance of any Unicode code point
+// from chars in s, or -1 if no Unicode code point from chars is present in s.
+//
+// Under the hood, it uses strings.IndexAny https://pkg.go.dev/strings#IndexAny
+func IndexAny(s, chars hotcoalString) int {
+	return s.IndexAny(chars)
+}
+
+// IndexAny returns the index of the first instance of any Unicode code point
+// from chars in s, or -1 if no Unicode code point from chars is present in s.
+//
+// Under the hood, it uses strings.IndexAny https://pkg.go.dev/strings#IndexAny
+func (s hotcoalString) IndexAny(chars hotcoalString) int {
+	return strings.IndexAny(string(s), string(chars))
+}
+
+// Cut slices s around the first instance of sep, returning the text before and after sep.
+// The found result reports whether sep appears in s.
+// If sep does not appear in s, cut returns s, "", false.
+//
+// It works like strings.Cut https://pkg.go.dev/strings#Cut
+// which was added in Go 1.18.
+func (s hotcoalString) Cut(sep hotcoalString) (before, after hotcoalString, found bool) {
+	if i := s.Index(sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return s, "", false
+}
+
+// Dedent removes the common leading white space from the lines of s, and the blank lines
+// around them, e.g. of an indented raw string constant, like Python's textwrap.dedent.
+// Lines consisting only of white space are emptied and don't count for the common white space.
+// Tabs and spaces are different characters, so "\t" and "  " have no common white space.
+func Dedent(s hotcoalString) hotcoalString {
+	lines := strings.Split(string(s), "\n")
+
+	margin := ""
+	first := true
+
+	for i, line := range lines {
+		content := strings.TrimLeft(line, " \t")
+		if content == "" {
+			lines[i] = ""
+			continue
+		}
+
+		indent := line[:len(line)-len(content)]
+
+		if first {
+			margin = indent
+			first = false
+
+			continue
+		}
+
+		for !strings.HasPrefix(indent, margin) {
+			margin = margin[:len(margin)-1]
+		}
+	}
+
+	start, end := 0, len(lines)
+
+	for start < end && lines[start] == "" {
+		start++
+	}
+
+	for end > start && lines[end-1] == "" {
+		end--
+	}
+
+	lines = lines[start:end]
+
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, margin)
+	}
+
+	return hotcoalString(strings.Join(lines, "\n"))
+}
+
+// toSlice converts a slice of strings, which were derived from hotcoalStrings, to a Slice
+func toSlice(elems []string) Slice {
+	if elems == nil {
+		return nil
+	}
+
+	ret := make(Slice, 0, len(elems))
+	for _, el := range elems {
+		ret = append(ret, hotcoalString(el))
+	}
+
+	return ret
+}
@@ -1,11 +1,55 @@
 package hotcoal
 
-import "testing"
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
 
 func TestJoin(t *testing.T) {
 	if "foo-bar-tar" != Join(Slice{"foo", "bar", "tar"}, "-").String() {
 		t.Fail()
 	}
+
+	if "" != Join(nil, "-") || "" != Join(Slice{}, "-") || "foo" != Join(Slice{"foo"}, "-").String() {
+		t.Fail()
+	}
+
+	if "foobar" != Join(Slice{"foo", "bar"}, "").String() || "-" != Join(Slice{"", ""}, "-").String() {
+		t.Fail()
+	}
+}
+
+var benchmarkSlice = func() Slice {
+	ret := make(Slice, 1000)
+	for i := range ret {
+		ret[i] = "column_name"
+	}
+
+	return ret
+}()
+
+func BenchmarkJoin(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Join(benchmarkSlice, ", ")
+	}
+}
+
+// BenchmarkJoinStrings is the previous implementation of Join, which copied the elements to a []string
+func BenchmarkJoinStrings(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		stringElems := make([]string, 0, len(benchmarkSlice))
+		for _, el := range benchmarkSlice {
+			stringElems = append(stringElems, string(el))
+		}
+
+		_ = hotcoalString(strings.Join(stringElems, ", "))
+	}
 }
 
 func TestReplace(t *testing.T) {
@@ -19,3 +63,429 @@ func TestReplaceAll(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestSplit(t *testing.T) {
+	if !reflect.DeepEqual(Slice{"a", "b", "c"}, W("a,b,c").Split(",")) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"a", "b", "c"}, Split("a, b, c", ", ")) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"abc"}, W("abc").Split(",")) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"", "a", ""}, W(",a,").Split(",")) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{""}, W("").Split(",")) {
+		t.Fail()
+	}
+}
+
+func TestSplitEmptySeparator(t *testing.T) {
+	if !reflect.DeepEqual(Slice{"a", "b", "€"}, W("ab€").Split("")) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{}, Split("", "")) {
+		t.Fail()
+	}
+}
+
+func TestSplitN(t *testing.T) {
+	hs := W("key=value=extra")
+
+	if nil != hs.SplitN("=", 0) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"key=value=extra"}, hs.SplitN("=", 1)) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"key", "value=extra"}, hs.SplitN("=", 2)) {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{"key", "value", "extra"}, hs.SplitN("=", -1)) {
+		t.Fail()
+	}
+}
+
+func TestFields(t *testing.T) {
+	sql := W(`
+		SELECT first_name,	last_name
+		FROM users
+	WHERE id = ?  `)
+
+	if !reflect.DeepEqual(Slice{"SELECT", "first_name,", "last_name", "FROM", "users", "WHERE", "id", "=", "?"}, sql.Fields()) {
+		t.Fail()
+	}
+
+	if "SELECT first_name, last_name FROM users WHERE id = ?" != Join(sql.Fields(), " ").String() {
+		t.Fail()
+	}
+
+	if !reflect.DeepEqual(Slice{}, W(" \t\n ").Fields()) {
+		t.Fail()
+	}
+}
+
+func TestTrimSpace(t *testing.T) {
+	if "SELECT *" != W("\n\t SELECT *  \n").TrimSpace().String() {
+		t.Fail()
+	}
+
+	if "SELECT *" != TrimSpace("SELECT *").String() {
+		t.Fail()
+	}
+
+	if "" != TrimSpace(" \t\n ").String() {
+		t.Fail()
+	}
+}
+
+func TestTrim(t *testing.T) {
+	if "foo" != W(", foo,,").Trim(", ").String() {
+		t.Fail()
+	}
+
+	if "foo" != Trim("foo", ",").String() {
+		t.Fail()
+	}
+
+	if "" != Trim(",,, ", ", ").String() {
+		t.Fail()
+	}
+}
+
+func TestTrimPrefix(t *testing.T) {
+	if "first_name = ?" != W(" AND first_name = ?").TrimPrefix(" AND ").String() {
+		t.Fail()
+	}
+
+	if "first_name = ?" != TrimPrefix("first_name = ?", " AND ").String() {
+		t.Fail()
+	}
+
+	if "" != TrimPrefix(" AND ", " AND ").String() {
+		t.Fail()
+	}
+}
+
+func TestTrimSuffix(t *testing.T) {
+	if "?, ?, ?" != W("?, ?, ?, ").TrimSuffix(", ").String() {
+		t.Fail()
+	}
+
+	if "?, ?, ?" != TrimSuffix("?, ?, ?", ", ").String() {
+		t.Fail()
+	}
+
+	if "" != TrimSuffix(", ", ", ").String() {
+		t.Fail()
+	}
+}
+
+func TestToUpper(t *testing.T) {
+	if "SELECT * FROM USERS" != W("select * from users").ToUpper().String() {
+		t.Fail()
+	}
+
+	if "SELECT" != ToUpper("Select").String() {
+		t.Fail()
+	}
+}
+
+func TestToLower(t *testing.T) {
+	if "select * from users" != W("SELECT * FROM Users").ToLower().String() {
+		t.Fail()
+	}
+
+	if "select" != ToLower("Select").String() {
+		t.Fail()
+	}
+}
+
+func TestToUpperSpecial(t *testing.T) {
+	if "LIMIT" != W("limit").ToUpper().String() {
+		t.Fail()
+	}
+
+	if "LİMİT" != W("limit").ToUpperSpecial(unicode.TurkishCase).String() {
+		t.Fail()
+	}
+
+	if "WHERİ" != ToUpperSpecial(unicode.TurkishCase, "wheri").String() {
+		t.Fail()
+	}
+}
+
+func TestToLowerSpecial(t *testing.T) {
+	if "limit" != W("LIMIT").ToLower().String() {
+		t.Fail()
+	}
+
+	if "lımıt" != W("LIMIT").ToLowerSpecial(unicode.TurkishCase).String() {
+		t.Fail()
+	}
+
+	if "limit" != ToLowerSpecial(unicode.TurkishCase, "LİMİT").String() {
+		t.Fail()
+	}
+}
+
+func TestTitle(t *testing.T) {
+	if "First_Name" != W("first_name").Title().String() || "Created At 2" != W("created at 2").Title().String() {
+		t.Fail()
+	}
+
+	// the other letters are not modified
+	if "ID" != W("ID").Title().String() || "HTTPStatus" != W("hTTPStatus").Title().String() || "" != W("").Title() {
+		t.Fail()
+	}
+
+	// the title case of a digraph differs from its upper case
+	if "ǅemal" != W("ǆemal").Title().String() {
+		t.Fail()
+	}
+}
+
+func TestTitleSpecial(t *testing.T) {
+	if "İlçe_Adı" != W("ilçe_adı").TitleSpecial(unicode.TurkishCase).String() {
+		t.Fail()
+	}
+
+	if "Ilçe_Adı" != W("ilçe_adı").Title().String() {
+		t.Fail()
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	sql := W("SELECT * FROM users")
+
+	if !sql.HasPrefix("SELECT") || sql.HasPrefix("UPDATE") || !sql.HasPrefix("") || !W("").HasPrefix("") {
+		t.Fail()
+	}
+}
+
+func TestHasSuffix(t *testing.T) {
+	sql := W("SELECT * FROM users;")
+
+	if !sql.HasSuffix(";") || sql.HasSuffix("users") || !sql.HasSuffix("") || !W("").HasSuffix("") {
+		t.Fail()
+	}
+}
+
+func TestContains(t *testing.T) {
+	sql := W("SELECT * FROM users WHERE id = ?")
+
+	if !sql.Contains("WHERE") || sql.Contains("ORDER BY") || !sql.Contains("") || W("").Contains("?") {
+		t.Fail()
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	if "?, ?, ?" != W("?, ").Repeat(3).TrimSuffix(", ").String() {
+		t.Fail()
+	}
+
+	if "" != Repeat("?, ", 0).String() {
+		t.Fail()
+	}
+
+	if result := Repeat("?, ", 10000); len(result) != 30000 || !result.HasPrefix("?, ?, ") || !result.HasSuffix("?, ") {
+		t.Fail()
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+
+		Repeat("?", -1)
+	}()
+}
+
+func TestEqual(t *testing.T) {
+	if !W("users").Equal("users") || W("users").Equal("Users") || !W("").Equal("") {
+		t.Fail()
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	if !W("Select").EqualFold("SELECT") || !EqualFold("order by", "ORDER BY") || W("select").EqualFold("selects") {
+		t.Fail()
+	}
+
+	// Kelvin sign, long s and Greek sigma variants fold together
+	if !W("\u212A").EqualFold("k") || !W("ſ").EqualFold("S") || !EqualFold("ΣΑΣ", "σας") {
+		t.Fail()
+	}
+
+	// simple folding doesn't expand ß to ss
+	if W("straße").EqualFold("STRASSE") {
+		t.Fail()
+	}
+}
+
+func TestCompare(t *testing.T) {
+	if 0 != W("users").Compare("users") {
+		t.Fail()
+	}
+
+	// uppercase letters sort before lowercase letters
+	if -1 != W("Users").Compare("users") || 1 != W("users").Compare("Users") {
+		t.Fail()
+	}
+
+	if -1 != W("Zebra").Compare("apple") || 1 != W("apple").Compare("") {
+		t.Fail()
+	}
+}
+
+func TestCount(t *testing.T) {
+	sql := W("SELECT * FROM users WHERE id = ? AND name = ?")
+
+	if 2 != sql.Count("?") || 2 != Count(sql, "?") || 0 != sql.Count("$1") {
+		t.Fail()
+	}
+
+	// an empty substr matches before and after each rune
+	if 1 != W("").Count("") || 4 != W("héj").Count("") || 4 != Count("héj", "") {
+		t.Fail()
+	}
+}
+
+func TestIndex(t *testing.T) {
+	sql := W("SELECT * FROM users WHERE id = ?")
+
+	if 20 != sql.Index("WHERE") || 20 != Index(sql, "WHERE") || -1 != sql.Index("ORDER BY") || 0 != sql.Index("") {
+		t.Fail()
+	}
+}
+
+func TestMap(t *testing.T) {
+	dropControl := func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}
+
+	if "SELECT 1" != W("SELECT\x00 1\x07").Map(dropControl).String() {
+		t.Fail()
+	}
+
+	upperASCII := func(r rune) rune {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+
+		return r
+	}
+
+	if "SELECT é" != W("select é").Map(upperASCII).String() {
+		t.Fail()
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	if "u.id" != W("id").Prefix("u.").String() || "u." != W("").Prefix("u.").String() || "id" != W("id").Prefix("").String() {
+		t.Fail()
+	}
+}
+
+func TestSuffix(t *testing.T) {
+	if "id = ?" != W("id").Suffix(" = ?").String() || " = ?" != W("").Suffix(" = ?").String() || "id" != W("id").Suffix("").String() {
+		t.Fail()
+	}
+
+	columns := Slice{"first_name", "last_name"}.Map(func(c hotcoalString) hotcoalString {
+		return c.Prefix("u.").Suffix(" = ?")
+	})
+
+	if "u.first_name = ?, u.last_name = ?" != columns.Join(", ").String() {
+		t.Fail()
+	}
+}
+
+func TestLastIndex(t *testing.T) {
+	sql := W("SELECT * FROM users WHERE id = ? AND name = ?")
+
+	if 44 != sql.LastIndex("?") || 44 != LastIndex(sql, "?") || -1 != sql.LastIndex("$1") || len(sql) != sql.LastIndex("") {
+		t.Fail()
+	}
+}
+
+func TestIndexAny(t *testing.T) {
+	sql := W("SELECT * FROM users WHERE id = ?")
+
+	if 7 != sql.IndexAny("?*") || 7 != IndexAny(sql, "*?") || -1 != sql.IndexAny("$;") || -1 != sql.IndexAny("") {
+		t.Fail()
+	}
+}
+
+func TestCut(t *testing.T) {
+	before, after, found := W("first_name:name").Cut(":")
+	if "first_name" != before.String() || "name" != after.String() || !found {
+		t.Fail()
+	}
+
+	before, after, found = W("a:b:c").Cut(":")
+	if "a" != before.String() || "b:c" != after.String() || !found {
+		t.Fail()
+	}
+
+	before, after, found = W("first_name").Cut(":")
+	if "first_name" != before.String() || "" != after.String() || found {
+		t.Fail()
+	}
+
+	// an empty separator is found at the start
+	before, after, found = W("first_name").Cut("")
+	if "" != before.String() || "first_name" != after.String() || !found {
+		t.Fail()
+	}
+}
+
+func TestDedent(t *testing.T) {
+	sql := W(`
+		SELECT first_name, last_name
+		FROM users
+
+		WHERE id = ?
+		  AND deleted_at IS NULL
+	`)
+
+	expected := "SELECT first_name, last_name\nFROM users\n\nWHERE id = ?\n  AND deleted_at IS NULL"
+	if expected != Dedent(sql).String() {
+		t.Errorf("%q", Dedent(sql))
+	}
+
+	for s, expected := range map[hotcoalString]string{
+		"":                   "",
+		"SELECT 1":           "SELECT 1",
+		"   SELECT 1   ":     "SELECT 1   ",
+		"\n\n  SELECT 1\n\n": "SELECT 1",
+		"  a\n    b\n  c":    "a\n  b\nc",
+		"    a\n  b":         "  a\nb",
+		"\ta\n\t\tb":         "a\n\tb",
+		"\t  a\n\t b":        " a\nb",
+		"\ta\n  b":           "\ta\n  b",
+		"  a\n \t \n  b":     "a\n\nb",
+		" \n \t\n ":          "",
+		"a\n  b":             "a\n  b",
+	} {
+		if actual := Dedent(s).String(); expected != actual {
+			t.Errorf("%q: expected %q, actual %q", s, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package hotcoal
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Template is a hotcoalString with named tokens, e.g. "SELECT * FROM {{table}} WHERE {{column}} = ?",
+// which are replaced by hotcoalStrings using Render.
+// Create it using NewTemplate.
+type Template struct {
+	// parts are the hotcoalStrings around the tokens, len(parts) == len(tokens) + 1
+	parts  Slice
+	tokens []string
+}
+
+// NewTemplate parses the template and returns a Template.
+// The tokens are written as {{name}}, where the name starts with an ASCII letter or an underscore,
+// followed by ASCII letters, digits or underscores.
+// If a token is not closed or its name is not valid, it returns an error.
+func NewTemplate(template hotcoalString) (Template, error) {
+	ret := Template{}
+	rest := string(template)
+
+	for {
+		start := strings.Index(rest, "{{")
+		if start == -1 {
+			break
+		}
+
+		end := strings.Index(rest[start+2:], "}}")
+		if end == -1 {
+			return Template{}, fmt.Errorf("Hotcoal template error - token at %#v is not closed", rest[start:])
+		}
+
+		name := rest[start+2 : start+2+end]
+		if !isName(name) {
+			return Template{}, fmt.Errorf("Hotcoal template error - token name %#v is not valid", name)
+		}
+
+		ret.parts = append(ret.parts, hotcoalString(rest[:start]))
+		ret.tokens = append(ret.tokens, name)
+		rest = rest[start+2+end+2:]
+	}
+
+	ret.parts = append(ret.parts, hotcoalString(rest))
+
+	return ret, nil
+}
+
+// Render replaces the tokens of the template with the hotcoalStrings of values.
+// If a token is missing from values, values contains a name which is not a token of the template,
+// or the result still contains a {{name}} token coming from one of the values, it returns an error.
+func (t Template) Render(values Map) (hotcoalString, error) {
+	ret, err := t.render(values, false)
+	if err != nil {
+		return "", err
+	}
+
+	if name, ok := findToken(string(ret)); ok {
+		return "", fmt.Errorf("Hotcoal template error - result contains unreplaced token %#v", name)
+	}
+
+	return ret, nil
+}
+
+// MustRender is like Render, but panics if there is an error.
+// It is useful for templates rendered at startup.
+func (t Template) MustRender(values Map) hotcoalString {
+	ret, err := t.Render(values)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// RenderPartial is like Render, but leaves the tokens missing from values as they are,
+// and doesn't check the result for leftover tokens.
+// It is useful for rendering a template in multiple passes.
+// If values contains a name which is not a token of the template, it returns an error.
+func (t Template) RenderPartial(values Map) (hotcoalString, error) {
+	return t.render(values, true)
+}
+
+func (t Template) render(values Map, partial bool) (hotcoalString, error) {
+	tokens := make(map[string]bool, len(t.tokens))
+
+	for _, name := range t.tokens {
+		if _, ok := values[name]; !ok && !partial {
+			return "", fmt.Errorf("Hotcoal template error - value of token %#v is missing", name)
+		}
+
+		tokens[name] = true
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !tokens[name] {
+			return "", fmt.Errorf("Hotcoal template error - template has no token %#v", name)
+		}
+	}
+
+	var b Builder
+
+	for i, name := range t.tokens {
+		b.Write(t.parts[i])
+
+		value, ok := values[name]
+		if !ok {
+			value = hotcoalString("{{" + name + "}}")
+		}
+
+		b.Write(value)
+	}
+
+	b.Write(t.parts[len(t.parts)-1])
+
+	return b.HotcoalString(), nil
+}
+
+// findToken returns the name of the first {{name}} token of s
+func findToken(s string) (string, bool) {
+	for {
+		start := strings.Index(s, "{{")
+		if start == -1 {
+			return "", false
+		}
+
+		s = s[start+2:]
+
+		end := strings.Index(s, "}}")
+		if end == -1 {
+			return "", false
+		}
+
+		if isName(s[:end]) {
+			return s[:end], true
+		}
+	}
+}
+
+// RenderPositional replaces the positional markers :1, :2, ... :N of the template
+// with the corresponding hotcoalString of args, e.g. :1 with args[0].
+// If a marker is out of range, or an arg is not used, it returns an error.
+//
+// Markers inside string literals, quoted identifiers and comments are not replaced,
+// nor are PostgreSQL casts like ::int.
+func RenderPositional(template hotcoalString, args Slice) (hotcoalString, error) {
+	used := make([]bool, len(args))
+
+	var b Builder
+	b.Grow(len(template))
+
+	for _, segment := range splitSQL(string(template)) {
+		if segment.kind != sqlCode {
+			b.Write(hotcoalString(segment.text))
+			continue
+		}
+
+		text := segment.text
+		start := 0
+
+		for i := 0; i < len(text); i++ {
+			if text[i] != ':' || (i > 0 && text[i-1] == ':') {
+				continue
+			}
+
+			end := i + 1
+			for end < len(text) && '0' <= text[end] && text[end] <= '9' {
+				end++
+			}
+
+			if end == i+1 {
+				continue
+			}
+
+			n, err := strconv.Atoi(text[i+1 : end])
+			if err != nil || n < 1 || n > len(args) {
+				return "", fmt.Errorf("Hotcoal template error - marker %s is out of range, there are %d args", text[i:end], len(args))
+			}
+
+			used[n-1] = true
+			b.Write(hotcoalString(text[start:i])).Write(args[n-1])
+
+			start = end
+			i = end - 1
+		}
+
+		b.Write(hotcoalString(text[start:]))
+	}
+
+	for i, el := range used {
+		if !el {
+			return "", fmt.Errorf("Hotcoal template error - arg %d is not used", i+1)
+		}
+	}
+
+	return b.HotcoalString(), nil
+}
+
+// isName reports whether s starts with an ASCII letter or an underscore,
+// followed by ASCII letters, digits or underscores
+func isName(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		valid := c == '_' ||
+			('a' <= c && c <= 'z') ||
+			('A' <= c && c <= 'Z') ||
+			(i > 0 && '0' <= c && c <= '9')
+
+		if !valid {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,186 @@
+package hotcoal
+
+import "testing"
+
+func TestTemplate(t *testing.T) {
+	template, err := NewTemplate("SELECT * FROM {{table}} WHERE {{column}} = ? OR {{column}} IS NULL;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs, err := template.Render(Map{"table": "users", "column": "nickname"})
+	if "SELECT * FROM users WHERE nickname = ? OR nickname IS NULL;" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs = template.MustRender(Map{"table": "users", "column": "id"})
+	if "SELECT * FROM users WHERE id = ? OR id IS NULL;" != hs.String() {
+		t.Fail()
+	}
+}
+
+func TestTemplateWithoutTokens(t *testing.T) {
+	for _, el := range []hotcoalString{"", "SELECT 1", "SELECT '}}'"} {
+		template, err := NewTemplate(el)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hs, err := template.Render(nil)
+		if el != hs || err != nil {
+			t.Fail()
+		}
+	}
+
+	template, err := NewTemplate("{{a}}{{b}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs, err := template.Render(Map{"a": "x", "b": "y"})
+	if "xy" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestTemplateRenderError(t *testing.T) {
+	template, err := NewTemplate("SELECT * FROM {{table}} WHERE {{column}} = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs, err := template.Render(Map{"table": "users"})
+	if "" != hs.String() || err == nil || `Hotcoal template error - value of token "column" is missing` != err.Error() {
+		t.Fail()
+	}
+
+	hs, err = template.Render(Map{"table": "users", "column": "id", "order": "id"})
+	if "" != hs.String() || err == nil || `Hotcoal template error - template has no token "order"` != err.Error() {
+		t.Fail()
+	}
+}
+
+func TestNewTemplateError(t *testing.T) {
+	for _, el := range []hotcoalString{
+		"SELECT * FROM {{table",
+		"SELECT * FROM {{}}",
+		"SELECT * FROM {{ table }}",
+		"SELECT * FROM {{1table}}",
+		"SELECT * FROM {{table-name}}",
+		"SELECT * FROM {{table}} WHERE {{col umn}} = ?",
+	} {
+		if _, err := NewTemplate(el); err == nil {
+			t.Errorf("%q should not be valid", el)
+		}
+	}
+}
+
+func TestTemplateRenderLeftoverToken(t *testing.T) {
+	template, err := NewTemplate("SELECT * FROM {{table}} WHERE {{column}} = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs, err := template.Render(Map{"table": "{{schema}}.users", "column": "id"})
+	if "" != hs.String() || err == nil || `Hotcoal template error - result contains unreplaced token "schema"` != err.Error() {
+		t.Fail()
+	}
+
+	// not a valid token name
+	hs, err = template.Render(Map{"table": "users", "column": "'{{ }}'"})
+	if "SELECT * FROM users WHERE '{{ }}' = ?" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestTemplateMustRender(t *testing.T) {
+	template, err := NewTemplate("SELECT * FROM {{table}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fail()
+		}
+	}()
+
+	template.MustRender(nil)
+}
+
+func TestTemplateRenderPartial(t *testing.T) {
+	template, err := NewTemplate("SELECT * FROM {{table}} WHERE {{column}} = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs, err := template.RenderPartial(Map{"table": "{{schema}}.users"})
+	if "SELECT * FROM {{schema}}.users WHERE {{column}} = ?" != hs.String() || err != nil {
+		t.Fatal(hs, err)
+	}
+
+	template, err = NewTemplate(hs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs, err = template.Render(Map{"schema": "public", "column": "id"})
+	if "SELECT * FROM public.users WHERE id = ?" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = template.RenderPartial(Map{"order": "id"})
+	if "" != hs.String() || err == nil || `Hotcoal template error - template has no token "order"` != err.Error() {
+		t.Fail()
+	}
+}
+
+func TestRenderPositional(t *testing.T) {
+	hs, err := RenderPositional("SELECT :2 FROM :1 WHERE :2 = ?", Slice{"users", "nickname"})
+	if "SELECT nickname FROM users WHERE nickname = ?" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	args := make(Slice, 11)
+	for i := range args {
+		args[i] = Itoa(i + 1)
+	}
+
+	hs, err = RenderPositional("SELECT :1, :10, :11 + :2:3:4:5:6:7:8:9", args)
+	if "SELECT 1, 10, 11 + 23456789" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	// quoted strings, comments and casts are not markers
+	hs, err = RenderPositional("SELECT ':2', \":2\", x::int, :1 -- :2\n", Slice{"id"})
+	if "SELECT ':2', \":2\", x::int, id -- :2\n" != hs.String() || err != nil {
+		t.Fail()
+	}
+
+	hs, err = RenderPositional("SELECT 1", nil)
+	if "SELECT 1" != hs.String() || err != nil {
+		t.Fail()
+	}
+}
+
+func TestRenderPositionalError(t *testing.T) {
+	hs, err := RenderPositional("SELECT :1 FROM :3", Slice{"id", "users"})
+	if "" != hs.String() || err == nil || "Hotcoal template error - marker :3 is out of range, there are 2 args" != err.Error() {
+		t.Fail()
+	}
+
+	hs, err = RenderPositional("SELECT :0 FROM users", Slice{"id"})
+	if "" != hs.String() || err == nil {
+		t.Fail()
+	}
+
+	hs, err = RenderPositional("SELECT :1 FROM users", Slice{"id", "users"})
+	if "" != hs.String() || err == nil || "Hotcoal template error - arg 2 is not used" != err.Error() {
+		t.Fail()
+	}
+
+	hs, err = RenderPositional("SELECT :99999999999999999999999", Slice{"id"})
+	if "" != hs.String() || err == nil {
+		t.Fail()
+	}
+}
@@ -0,0 +1,10 @@
+package hotcoal
+
+import "database/sql/driver"
+
+// Value implements driver.Valuer https://pkg.go.dev/database/sql/driver#Valuer
+// which allows you to pass a hotcoalString as an argument of a query,
+// e.g. a value validated by an allowlist, without calling String.
+func (s hotcoalString) Value() (driver.Value, error) {
+	return string(s), nil
+}
@@ -0,0 +1,20 @@
+package hotcoal
+
+import (
+	"database/sql/driver"
+	"testing"
+)
+
+var _ driver.Valuer = hotcoalString("")
+
+func TestValue(t *testing.T) {
+	value, err := Allowlist("admin", "user").MustValidate("admin").Value()
+	if "admin" != value || err != nil {
+		t.Fail()
+	}
+
+	// the value is a plain string, which is a valid driver.Value
+	if _, ok := value.(string); !ok {
+		t.Fail()
+	}
+}